@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+data "netlify_build_hooks" "test" {
+  site_id = "NETLIFY_SITE_ID"
+}
+
+output "hook_titles" {
+  value = data.netlify_build_hooks.test.hooks[*].title
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+variable "deploy_id" {
+  type = string
+}
+
+data "netlify_deploy" "test" {
+  id = var.deploy_id
+}
+
+output "deploy_url" {
+  value = data.netlify_deploy.test.deploy_url
+}
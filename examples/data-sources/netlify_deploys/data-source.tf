@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+data "netlify_site" "test" {
+  name = "my-site"
+}
+
+data "netlify_deploys" "production" {
+  site_id    = data.netlify_site.test.id
+  production = true
+  state      = "ready"
+  max_items  = 2
+}
+
+# Roll production back to the deploy before the current one.
+resource "netlify_site_published_deploy" "rollback" {
+  site_id   = data.netlify_site.test.id
+  deploy_id = data.netlify_deploys.production.deploys[1].id
+  locked    = true
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+data "netlify_dns_zone" "test" {
+  name = "example.com"
+}
+
+output "name_servers" {
+  value = data.netlify_dns_zone.test.dns_servers
+}
@@ -12,6 +12,14 @@ data "netlify_site" "test" {
   id = "NETLIFY_SITE_ID"
 }
 
+data "netlify_site" "by_name" {
+  name = "my-site"
+}
+
+data "netlify_site" "by_domain" {
+  custom_domain = "www.example.com"
+}
+
 output "test" {
   value = data.netlify_site.test
 }
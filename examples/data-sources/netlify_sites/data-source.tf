@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+data "netlify_sites" "frontend" {
+  name      = "frontend"
+  filter    = "owner"
+  repo_path = "USER/REPO_NAME"
+}
+
+output "frontend_site_ids" {
+  value = data.netlify_sites.frontend.sites[*].id
+}
@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+resource "netlify_deploy_key" "test" {}
+
+data "netlify_current_user" "me" {}
+
+resource "netlify_site" "test" {
+  repository = {
+    provider      = "github"
+    repo_path     = "USER/repo"
+    repo_branch   = "main"
+    deploy_key_id = netlify_deploy_key.test.id
+    cmd           = "npm run build"
+    dir           = "build"
+  }
+}
+
+resource "netlify_env_var" "api_url" {
+  account_slug = data.netlify_current_user.me.slug
+  site_id      = netlify_site.test.id
+  key          = "API_URL"
+
+  values = [
+    {
+      context = "all"
+      value   = "https://api.example.com"
+    },
+  ]
+}
+
+# Rebuild the site whenever the build command or the variable change.
+resource "netlify_build" "test" {
+  site_id = netlify_site.test.id
+
+  triggers = {
+    cmd     = netlify_site.test.repository.cmd
+    api_url = netlify_env_var.api_url.values[0].value
+  }
+
+  timeouts {
+    create = "30m"
+  }
+}
@@ -0,0 +1,2 @@
+# Build hooks can be imported by site ID and hook ID
+terraform import netlify_build_hook.cms SITE_ID:HOOK_ID
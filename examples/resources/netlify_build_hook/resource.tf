@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+resource "netlify_site" "test" {
+  name = "my-site"
+}
+
+resource "netlify_build_hook" "cms" {
+  site_id = netlify_site.test.id
+  title   = "CMS"
+  branch  = "main"
+}
+
+output "cms_hook_url" {
+  value     = netlify_build_hook.cms.url
+  sensitive = true
+}
@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+resource "netlify_site" "test" {
+  name = "my-site"
+}
+
+# Publish the static assets built by the CI, along with the function archives
+# produced by zip-it-and-ship-it.
+resource "netlify_deploy" "test" {
+  site_id             = netlify_site.test.id
+  directory           = "${path.module}/dist"
+  functions_directory = "${path.module}/.netlify/functions"
+  title               = "Deployed with Terraform"
+}
+
+# Publish a prebuilt zip artifact as a draft deploy.
+resource "netlify_deploy" "preview" {
+  site_id    = netlify_site.test.id
+  source_zip = "${path.module}/artifact.zip"
+  draft      = true
+}
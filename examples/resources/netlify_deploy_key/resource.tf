@@ -8,4 +8,14 @@ terraform {
 
 provider "netlify" {}
 
-resource "netlify_deploy_key" "test" {}
+# Changing rotated_at replaces the key with a new one.
+resource "netlify_deploy_key" "test" {
+  rotation_triggers = {
+    rotated_at = "2024-01-01"
+  }
+}
+
+# The fingerprint is the one shown in the repository deploy keys settings.
+output "deploy_key_fingerprint" {
+  value = netlify_deploy_key.test.fingerprint
+}
@@ -0,0 +1,2 @@
+# Deploy notifications can be imported by ID
+terraform import netlify_deploy_notification.failed_slack HOOK_ID
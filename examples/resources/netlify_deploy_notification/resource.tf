@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+resource "netlify_site" "test" {
+  name = "my-site"
+}
+
+resource "netlify_deploy_notification" "failed_slack" {
+  site_id = netlify_site.test.id
+  type    = "slack"
+  event   = "deploy_failed"
+  data = {
+    url = "https://hooks.slack.com/services/T000/B000/XXXX"
+  }
+}
+
+resource "netlify_deploy_notification" "created_email" {
+  site_id = netlify_site.test.id
+  type    = "email"
+  event   = "deploy_created"
+  data = {
+    email = "ops@example.com"
+  }
+}
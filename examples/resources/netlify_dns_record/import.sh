@@ -0,0 +1,2 @@
+# DNS records can be imported by zone ID and record ID
+terraform import netlify_dns_record.www ZONE_ID:RECORD_ID
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+resource "netlify_dns_zone" "test" {
+  name = "example.com"
+}
+
+resource "netlify_dns_record" "www" {
+  zone_id  = netlify_dns_zone.test.id
+  type     = "CNAME"
+  hostname = "www.example.com"
+  value    = "my-site.netlify.app"
+}
+
+resource "netlify_dns_record" "mx" {
+  zone_id  = netlify_dns_zone.test.id
+  type     = "MX"
+  hostname = "example.com"
+  value    = "mail.example.com"
+  priority = 10
+}
+
+resource "netlify_dns_record" "caa" {
+  zone_id  = netlify_dns_zone.test.id
+  type     = "CAA"
+  hostname = "example.com"
+  value    = "letsencrypt.org"
+  flag     = 0
+  tag      = "issue"
+}
@@ -0,0 +1,2 @@
+# DNS zones can be imported by ID
+terraform import netlify_dns_zone.test ZONE_ID
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+resource "netlify_site" "test" {
+  custom_domain = "example.com"
+}
+
+resource "netlify_dns_zone" "test" {
+  name    = "example.com"
+  site_id = netlify_site.test.id
+}
+
+output "name_servers" {
+  value = netlify_dns_zone.test.dns_servers
+}
@@ -31,4 +31,20 @@ resource "netlify_env_var" "test_env" {
   account_slug = data.netlify_current_user.me.slug
   site_id      = resource.netlify_site.test.id
   key          = "test"
+
+  values = [
+    {
+      context = "production"
+      value   = "prod-value"
+    },
+    {
+      context = "deploy-preview"
+      value   = "preview-value"
+    },
+    {
+      context           = "branch"
+      context_parameter = "staging"
+      value             = "staging-value"
+    },
+  ]
 }
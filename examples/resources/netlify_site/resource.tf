@@ -22,5 +22,23 @@ resource "netlify_site" "test" {
     deploy_key_id = netlify_deploy_key.test.id
     cmd           = "npm run build"
     dir           = "build"
+
+    functions_dir    = "netlify/functions"
+    allowed_branches = ["main", "develop"]
+    env = {
+      NODE_VERSION = "18"
+    }
   }
 }
+
+resource "netlify_site" "manual" {
+  name = "manual-deploys"
+}
+
+resource "netlify_site" "www" {
+  name           = "www"
+  custom_domain  = "example.com"
+  domain_aliases = ["www.example.com"]
+  force_ssl      = true
+  provision_ssl  = true
+}
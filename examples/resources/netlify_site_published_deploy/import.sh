@@ -0,0 +1,2 @@
+# The published deploy of a site can be imported by site ID
+terraform import netlify_site_published_deploy.test SITE_ID
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+variable "pinned_deploy_id" {
+  description = "Deploy to roll production back to during an incident"
+  type        = string
+}
+
+resource "netlify_site" "test" {
+  name = "my-site"
+}
+
+# Pin production to a known deploy, new deploys are not published until the
+# resource is removed or locked is set to false.
+resource "netlify_site_published_deploy" "test" {
+  site_id   = netlify_site.test.id
+  deploy_id = var.pinned_deploy_id
+  locked    = true
+}
@@ -0,0 +1,3 @@
+# The certificate of a site can be imported by site ID. The API does not
+# return the certificate and key, so the next apply uploads them again.
+terraform import netlify_site_ssl_certificate.test SITE_ID
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    netlify = {
+      source = "rouche-q/netlify"
+    }
+  }
+}
+
+provider "netlify" {}
+
+resource "netlify_site" "test" {
+  custom_domain = "example.com"
+}
+
+resource "netlify_site_ssl_certificate" "test" {
+  site_id         = netlify_site.test.id
+  certificate     = file("certs/example.com.crt")
+  key             = file("certs/example.com.key")
+  ca_certificates = file("certs/intermediate.crt")
+}
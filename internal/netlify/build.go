@@ -0,0 +1,100 @@
+package netlify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Build runs the build command of a site and produces a deploy.
+type Build struct {
+	Id        string `json:"id"`
+	DeployId  string `json:"deploy_id"`
+	Sha       string `json:"sha"`
+	Done      bool   `json:"done"`
+	Error     string `json:"error"`
+	CreatedAt string `json:"created_at"`
+}
+
+type BuildRequest struct {
+	ClearCache bool `json:"clear_cache,omitempty"`
+}
+
+// BuildLogLine is one line of the output of a build.
+type BuildLogLine struct {
+	Message   string `json:"message"`
+	Timestamp string `json:"ts"`
+}
+
+// CreateSiteBuild starts a build of the linked repository of a site.
+func (c *NetlifyClient) CreateSiteBuild(ctx context.Context, siteId string, build BuildRequest) (*Build, error) {
+	jsonValue, err := json.Marshal(build)
+	if err != nil {
+		return nil, err
+	}
+
+	reqDo := Request{
+		Method: http.MethodPost,
+		Path:   "sites/" + siteId + "/builds",
+		Body:   bytes.NewBuffer(jsonValue),
+	}
+
+	var res Build
+	err = c.Do(ctx, reqDo, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *NetlifyClient) GetBuild(ctx context.Context, buildId string) (*Build, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "builds/" + buildId,
+		Body:   &bytes.Buffer{},
+	}
+
+	var build Build
+	err := c.Do(ctx, reqDo, &build)
+	if err != nil {
+		return nil, err
+	}
+
+	return &build, nil
+}
+
+func (c *NetlifyClient) GetBuildLog(ctx context.Context, buildId string) ([]BuildLogLine, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "builds/" + buildId + "/log",
+		Body:   &bytes.Buffer{},
+	}
+
+	var lines []BuildLogLine
+	err := c.Do(ctx, reqDo, &lines)
+	if err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// WaitForBuild polls a build until it is done. A failed build is not an
+// error, callers have to check its Error.
+func (c *NetlifyClient) WaitForBuild(ctx context.Context, buildId string) (*Build, error) {
+	var build *Build
+	err := c.poll(ctx, func() (bool, error) {
+		var err error
+		build, err = c.GetBuild(ctx, buildId)
+		if err != nil {
+			return false, err
+		}
+		return build.Done, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return build, nil
+}
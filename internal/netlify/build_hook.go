@@ -0,0 +1,115 @@
+package netlify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// BuildHook is a URL triggering a build of a site when it receives a POST
+// request. The URL is a secret.
+type BuildHook struct {
+	Id        string `json:"id"`
+	Title     string `json:"title"`
+	Branch    string `json:"branch"`
+	Url       string `json:"url"`
+	SiteId    string `json:"site_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type BuildHookRequest struct {
+	Title  string `json:"title"`
+	Branch string `json:"branch,omitempty"`
+}
+
+func (c *NetlifyClient) CreateBuildHook(ctx context.Context, siteId string, req BuildHookRequest) (*BuildHook, error) {
+	jsonValue, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	reqDo := Request{
+		Method:         http.MethodPost,
+		Path:           "sites/" + siteId + "/build_hooks",
+		Body:           bytes.NewBuffer(jsonValue),
+		RedactResponse: redactBuildHookUrls,
+	}
+
+	var hook BuildHook
+	err = c.Do(ctx, reqDo, &hook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hook, nil
+}
+
+func (c *NetlifyClient) GetBuildHook(ctx context.Context, siteId string, hookId string) (*BuildHook, error) {
+	reqDo := Request{
+		Method:         http.MethodGet,
+		Path:           "sites/" + siteId + "/build_hooks/" + hookId,
+		Body:           &bytes.Buffer{},
+		RedactResponse: redactBuildHookUrls,
+	}
+
+	var hook BuildHook
+	err := c.Do(ctx, reqDo, &hook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hook, nil
+}
+
+// UpdateBuildHook changes the title and branch of a hook. The API answers
+// with no content, so the hook has to be read again.
+func (c *NetlifyClient) UpdateBuildHook(ctx context.Context, siteId string, hookId string, req BuildHookRequest) error {
+	jsonValue, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	reqDo := Request{
+		Method: http.MethodPut,
+		Path:   "sites/" + siteId + "/build_hooks/" + hookId,
+		Body:   bytes.NewBuffer(jsonValue),
+	}
+	return c.Do(ctx, reqDo, nil)
+}
+
+func (c *NetlifyClient) DeleteBuildHook(ctx context.Context, siteId string, hookId string) error {
+	reqDo := Request{
+		Method: http.MethodDelete,
+		Path:   "sites/" + siteId + "/build_hooks/" + hookId,
+		Body:   &bytes.Buffer{},
+	}
+	return c.Do(ctx, reqDo, nil)
+}
+
+func (c *NetlifyClient) ListBuildHooks(ctx context.Context, siteId string) ([]BuildHook, error) {
+	reqDo := Request{
+		Method:         http.MethodGet,
+		Path:           "sites/" + siteId + "/build_hooks",
+		Body:           &bytes.Buffer{},
+		RedactResponse: redactBuildHookUrls,
+	}
+
+	var hooks []BuildHook
+	err := c.Do(ctx, reqDo, &hooks)
+	if err != nil {
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+// redactBuildHookUrls hides the URLs of a build hook response body, anyone
+// knowing them can trigger builds.
+func redactBuildHookUrls(body []byte) []byte {
+	return redactJSONObjects(body, func(hook map[string]any) {
+		if _, ok := hook["url"]; ok {
+			hook["url"] = redacted
+		}
+	})
+}
@@ -1,39 +1,118 @@
 package netlify
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
+const DefaultBaseURL = "https://api.netlify.com/api/v1/"
+
 type NetlifyClient struct {
 	BaseURL    *url.URL
 	HTTPClient *http.Client
+
+	// MaxRetries is the number of times a request is sent again after a
+	// rate limit, a server error or a connection failure. Server errors and
+	// broken connections are only retried for idempotent methods.
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// PollInterval is the time waited between two checks of a long running
+	// operation, such as a certificate being issued.
+	PollInterval time.Duration
+
+	rateLimit rateLimiter
+}
+
+// ClientOptions tunes the HTTP client used to reach the Netlify API. The zero
+// value gives a client using the default transport and no request timeout.
+type ClientOptions struct {
+	UserAgent string
+	// Timeout bounds a single HTTP request, retries excluded.
+	Timeout time.Duration
+	// ProxyURL overrides the proxy otherwise taken from the HTTPS_PROXY and
+	// NO_PROXY environment variables.
+	ProxyURL string
+	// CABundle holds PEM encoded certificates trusted in addition to the
+	// system pool.
+	CABundle []byte
+	// Debug logs full request and response bodies, with secrets redacted.
+	Debug bool
 }
 
 type NetlifyTransport struct {
-	T     http.RoundTripper
-	Token string
+	T         http.RoundTripper
+	Token     string
+	UserAgent string
+	Debug     bool
 }
 
 func (n NetlifyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Set("Authorization", "Bearer "+n.Token)
-	req.Header.Set("Content-Type", "application/json")
-	return n.T.RoundTrip(req)
+	// Requests default to JSON, uploads set their own content type.
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if n.UserAgent != "" {
+		req.Header.Set("User-Agent", n.UserAgent)
+	}
+
+	start := time.Now()
+	res, err := n.T.RoundTrip(req)
+	n.logRoundTrip(req, res, err, start)
+	return res, err
 }
 
-func NewNetlifyClient(baseUrl string, personalToken string) (*NetlifyClient, error) {
+func NewNetlifyClient(baseUrl string, personalToken string, opts ClientOptions) (*NetlifyClient, error) {
+	// Request paths are relative, so the base URL has to end with a slash.
+	if !strings.HasSuffix(baseUrl, "/") {
+		baseUrl += "/"
+	}
 	parsedURL, err := url.Parse(baseUrl)
 	if err != nil {
 		return nil, err
 	}
 
-	tr := &NetlifyTransport{Token: personalToken, T: http.DefaultTransport}
+	httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		httpTransport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if len(opts.CABundle) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(opts.CABundle) {
+			return nil, errors.New("no valid PEM certificate found in CA bundle")
+		}
+		httpTransport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	tr := &NetlifyTransport{Token: personalToken, UserAgent: opts.UserAgent, Debug: opts.Debug, T: httpTransport}
 	client := &http.Client{
 		Transport: tr,
+		Timeout:   opts.Timeout,
 	}
 
 	return &NetlifyClient{
-		BaseURL:    parsedURL,
-		HTTPClient: client,
+		BaseURL:      parsedURL,
+		HTTPClient:   client,
+		MaxRetries:   defaultMaxRetries,
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
+		PollInterval: defaultPollInterval,
 	}, nil
 }
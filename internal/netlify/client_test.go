@@ -0,0 +1,742 @@
+package netlify_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-netlify/internal/netlify"
+	"terraform-provider-netlify/internal/netlifytest"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func newTestClient(t *testing.T) (*netlify.NetlifyClient, *netlifytest.Server) {
+	t.Helper()
+
+	srv := netlifytest.NewServer()
+	t.Cleanup(srv.Close)
+
+	client, err := netlify.NewNetlifyClient(srv.BaseURL(), netlifytest.Token, netlify.ClientOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = 10 * time.Millisecond
+	client.PollInterval = time.Millisecond
+	return client, srv
+}
+
+func TestSiteLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	site, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "my-site"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if site.Name != "my-site" || site.Url != "https://my-site.netlify.app" {
+		t.Fatalf("unexpected site %+v", site)
+	}
+
+	site, err = client.UpdateSite(ctx, site.Id, netlify.SiteRequest{
+		Name: "renamed",
+		Repo: &netlify.Repository{Provider: "github", Path: "netlify/test", Branch: "main"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if site.Name != "renamed" || site.BuildSettings.RepoPath != "netlify/test" {
+		t.Fatalf("unexpected site %+v", site)
+	}
+	if site.BuildSettings.RepoBranch != "main" || site.BuildImage != "focal" || !site.ProcessingSettings.Html.PrettyUrls {
+		t.Fatalf("unexpected settings %+v", site)
+	}
+
+	site, err = client.UnlinkSiteRepository(ctx, site.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if site.BuildSettings.RepoPath != "" {
+		t.Fatalf("expected the repository to be unlinked, got %+v", site.BuildSettings)
+	}
+
+	if err := client.DeleteSite(ctx, site.Id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetSite(ctx, site.Id)
+	if !netlify.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestDoRetries(t *testing.T) {
+	ctx := context.Background()
+	client, srv := newTestClient(t)
+
+	srv.AddFault(netlifytest.Fault{Method: http.MethodGet, PathPrefix: "user", StatusCode: http.StatusTooManyRequests, Times: 2})
+	srv.AddFault(netlifytest.Fault{Method: http.MethodGet, PathPrefix: "user", StatusCode: http.StatusBadGateway, Times: 1})
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Slug != netlifytest.AccountSlug {
+		t.Fatalf("unexpected user %+v", user)
+	}
+	if got := len(srv.Requests()); got != 4 {
+		t.Fatalf("expected 4 requests, got %d", got)
+	}
+}
+
+func TestDoGivesUpAfterMaxRetries(t *testing.T) {
+	client, srv := newTestClient(t)
+	client.MaxRetries = 1
+
+	srv.AddFault(netlifytest.Fault{PathPrefix: "user", StatusCode: http.StatusInternalServerError})
+
+	_, err := client.GetCurrentUser(context.Background())
+	var apiErr *netlify.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected internal server error, got %v", err)
+	}
+	if apiErr.RequestId == "" {
+		t.Fatal("expected the request id to be decoded")
+	}
+	if got := len(srv.Requests()); got != 2 {
+		t.Fatalf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestDoDoesNotRetryServerErrorsOnPost(t *testing.T) {
+	ctx := context.Background()
+	client, srv := newTestClient(t)
+
+	srv.AddFault(netlifytest.Fault{Method: http.MethodPost, PathPrefix: "sites", StatusCode: http.StatusBadGateway, Times: 1})
+
+	_, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "my-site"})
+	var apiErr *netlify.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected bad gateway error, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Fatalf("expected 1 request, got %d", got)
+	}
+
+	srv.AddFault(netlifytest.Fault{Method: http.MethodPost, PathPrefix: "sites", StatusCode: http.StatusTooManyRequests, Times: 1})
+
+	if _, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "my-site"}); err != nil {
+		t.Fatalf("expected rate limited request to be retried, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 3 {
+		t.Fatalf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestDoHonorsContext(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.AddFault(netlifytest.Fault{PathPrefix: "user", Delay: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetCurrentUser(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestEnvVarValues(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	envVar, err := client.CreateEnvVar(ctx, netlifytest.AccountSlug, "site", netlify.EnvVar{
+		Key:      "TOKEN",
+		IsSecret: true,
+		Values: []netlify.EnvVarValue{
+			{Context: "production", Value: "secret"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(envVar.Values) != 1 || envVar.Values[0].Value != "" {
+		t.Fatalf("expected the secret value to be hidden, got %+v", envVar.Values)
+	}
+
+	envVar, err = client.SetEnvVarValue(ctx, netlifytest.AccountSlug, "site", "TOKEN", netlify.EnvVarValue{
+		Context:          "branch",
+		ContextParameter: "staging",
+		Value:            "other",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(envVar.Values) != 2 {
+		t.Fatalf("expected 2 values, got %+v", envVar.Values)
+	}
+
+	if err := client.DeleteEnvVarValue(ctx, netlifytest.AccountSlug, "site", "TOKEN", envVar.Values[0].Id); err != nil {
+		t.Fatal(err)
+	}
+	envVar, err = client.GetEnvVar(ctx, netlifytest.AccountSlug, "site", "TOKEN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(envVar.Values) != 1 || envVar.Values[0].Context != "branch" {
+		t.Fatalf("expected only the branch value left, got %+v", envVar.Values)
+	}
+}
+
+func TestDebugLogsRedactSecretResponses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/env/TOKEN"):
+			fmt.Fprint(w, `{"key":"TOKEN","is_secret":true,"values":[{"id":"1","context":"production","value":"env-secret"}]}`)
+		case strings.HasSuffix(r.URL.Path, "/env"):
+			fmt.Fprint(w, `[{"key":"TOKEN","is_secret":true,"values":[{"id":"1","context":"production","value":"env-secret"}]},{"key":"PUBLIC","values":[{"id":"2","context":"all","value":"public-value"}]}]`)
+		case strings.HasSuffix(r.URL.Path, "/build_hooks"):
+			fmt.Fprint(w, `[{"id":"1","title":"CMS","url":"https://api.netlify.com/build_hooks/hook-secret"}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := netlify.NewNetlifyClient(srv.URL, netlifytest.Token, netlify.ClientOptions{Debug: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	if _, err := client.GetEnvVar(ctx, netlifytest.AccountSlug, "site", "TOKEN"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListEnvVars(ctx, netlifytest.AccountSlug, "site", netlify.ListOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListBuildHooks(ctx, "site"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(logs.String(), "http_response_body") {
+		t.Fatalf("expected response bodies to be logged, got %s", logs.String())
+	}
+	for _, secret := range []string{"env-secret", "hook-secret"} {
+		if strings.Contains(logs.String(), secret) {
+			t.Fatalf("expected %q to be redacted, got %s", secret, logs.String())
+		}
+	}
+	if !strings.Contains(logs.String(), "public-value") {
+		t.Fatalf("expected values of variables that are not secret to be logged, got %s", logs.String())
+	}
+}
+
+func TestListSitesPaginates(t *testing.T) {
+	ctx := context.Background()
+	client, srv := newTestClient(t)
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.CreateSite(ctx, netlify.SiteRequest{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	before := len(srv.Requests())
+	sites, err := client.ListSites(ctx, netlify.ListSitesParams{}, netlify.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sites) != 5 {
+		t.Fatalf("expected 5 sites, got %d", len(sites))
+	}
+	if got := len(srv.Requests()) - before; got != 3 {
+		t.Fatalf("expected 3 page requests, got %d", got)
+	}
+
+	sites, err = client.ListSites(ctx, netlify.ListSitesParams{}, netlify.ListOptions{PerPage: 2, MaxItems: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sites) != 3 {
+		t.Fatalf("expected 3 sites, got %d", len(sites))
+	}
+}
+
+func TestProvisionSiteSSL(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	aliases := []string{"www.example.com"}
+	site, err := client.CreateSite(ctx, netlify.SiteRequest{CustomDomain: "example.com", DomainAliases: &aliases})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ssl, err := client.ProvisionSiteSSL(ctx, site.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ssl.State != "pending" {
+		t.Fatalf("expected a pending certificate, got %q", ssl.State)
+	}
+
+	ssl, err = client.WaitForSiteSSL(ctx, site.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ssl.State != "issued" || len(ssl.Domains) != 2 {
+		t.Fatalf("unexpected certificate %+v", ssl)
+	}
+
+	site, err = client.GetSite(ctx, site.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !site.SSL {
+		t.Fatal("expected SSL to be enabled")
+	}
+}
+
+func TestDNSZoneLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	zone, err := client.CreateDNSZone(ctx, netlify.DNSZoneRequest{AccountSlug: netlifytest.AccountSlug, Name: "example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zone.DNSServers) == 0 {
+		t.Fatalf("expected name servers, got %+v", zone)
+	}
+
+	zones, err := client.ListDNSZones(ctx, netlifytest.AccountSlug, netlify.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zones) != 1 || zones[0].Id != zone.Id {
+		t.Fatalf("unexpected zones %+v", zones)
+	}
+
+	// Without an account slug, the zone is created in the account of the token.
+	other, err := client.CreateDNSZone(ctx, netlify.DNSZoneRequest{Name: "example.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.AccountSlug != netlifytest.AccountSlug {
+		t.Fatalf("expected account %s, got %+v", netlifytest.AccountSlug, other)
+	}
+
+	if err := client.DeleteDNSZone(ctx, zone.Id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetDNSZone(ctx, zone.Id)
+	if !netlify.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestDNSRecords(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	zone, err := client.CreateDNSZone(ctx, netlify.DNSZoneRequest{Name: "example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	priority := int64(10)
+	record, err := client.CreateDNSRecord(ctx, zone.Id, netlify.DNSRecordRequest{
+		Type:     "MX",
+		Hostname: "example.com",
+		Value:    "mail.example.com",
+		Priority: &priority,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Priority != 10 || record.TTL != 3600 || record.DNSZoneId != zone.Id {
+		t.Fatalf("unexpected record %+v", record)
+	}
+
+	_, err = client.CreateDNSRecord(ctx, zone.Id, netlify.DNSRecordRequest{Type: "MX", Hostname: "example.com", Value: "mail.example.com"})
+	if !netlify.IsUnprocessable(err) {
+		t.Fatalf("expected an unprocessable error, got %v", err)
+	}
+
+	if err := client.DeleteDNSRecord(ctx, zone.Id, record.Id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetDNSRecord(ctx, zone.Id, record.Id)
+	if !netlify.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestBuildHookLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	site, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "hooked"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hook, err := client.CreateBuildHook(ctx, site.Id, netlify.BuildHookRequest{Title: "CMS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hook.Url == "" || hook.Branch != "main" {
+		t.Fatalf("unexpected hook %+v", hook)
+	}
+
+	if err := client.UpdateBuildHook(ctx, site.Id, hook.Id, netlify.BuildHookRequest{Title: "Headless CMS", Branch: "develop"}); err != nil {
+		t.Fatal(err)
+	}
+	hooks, err := client.ListBuildHooks(ctx, site.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hooks) != 1 || hooks[0].Title != "Headless CMS" || hooks[0].Branch != "develop" || hooks[0].Url != hook.Url {
+		t.Fatalf("unexpected hooks %+v", hooks)
+	}
+
+	if err := client.DeleteBuildHook(ctx, site.Id, hook.Id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetBuildHook(ctx, site.Id, hook.Id)
+	if !netlify.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestHookLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	site, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "notified"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hookTypes, err := client.ListHookTypes(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hookTypes) == 0 || len(hookTypes[0].Events) == 0 || len(hookTypes[0].Fields) == 0 {
+		t.Fatalf("unexpected hook types %+v", hookTypes)
+	}
+
+	hook, err := client.CreateHook(ctx, site.Id, netlify.HookRequest{Type: "slack", Event: "deploy_failed", Data: map[string]string{"url": "https://hooks.slack.com/x"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hook.SiteId != site.Id || hook.Data["url"] != "https://hooks.slack.com/x" {
+		t.Fatalf("unexpected hook %+v", hook)
+	}
+
+	_, err = client.UpdateHook(ctx, hook.Id, netlify.HookRequest{Type: "slack", Event: "submission_created", Data: map[string]string{"url": "https://hooks.slack.com/x"}})
+	if !netlify.IsUnprocessable(err) {
+		t.Fatalf("expected an unprocessable error, got %v", err)
+	}
+
+	hooks, err := client.ListHooks(ctx, site.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hooks) != 1 {
+		t.Fatalf("expected 1 hook, got %d", len(hooks))
+	}
+
+	if err := client.DeleteHook(ctx, hook.Id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetHook(ctx, hook.Id)
+	if !netlify.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestSiteBuild(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	site, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "built", Repo: &netlify.Repository{Cmd: "exit 1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	build, err := client.CreateSiteBuild(ctx, site.Id, netlify.BuildRequest{ClearCache: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if build.Done || build.DeployId == "" {
+		t.Fatalf("unexpected build %+v", build)
+	}
+
+	build, err = client.WaitForBuild(ctx, build.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !build.Done || build.Error == "" {
+		t.Fatalf("expected a failed build, got %+v", build)
+	}
+
+	lines, err := client.GetBuildLog(ctx, build.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) < 2 || lines[1].Message != "Clearing cache" {
+		t.Fatalf("unexpected build log %+v", lines)
+	}
+
+	_, err = client.WaitForDeploy(ctx, build.DeployId)
+	if err == nil || !strings.Contains(err.Error(), build.Error) {
+		t.Fatalf("expected the deploy to fail with the build error, got %v", err)
+	}
+}
+
+func TestDigestDeploy(t *testing.T) {
+	ctx := context.Background()
+	client, srv := newTestClient(t)
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"index.html":               "<h1>Hello</h1>",
+		"about/index.html":         "<h1>Hello</h1>",
+		"style.css":                "h1 { color: red; }",
+		".well-known/security.txt": "Contact: mailto:security@example.com",
+		".env":                     "SECRET=1",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	digest, err := netlify.DigestDirectory(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(digest.Files) != 4 || digest.Files["/about/index.html"] != digest.Files["/index.html"] {
+		t.Fatalf("unexpected files %v", digest.Files)
+	}
+	if _, ok := digest.Files["/.env"]; ok {
+		t.Fatal("hidden files must not be deployed")
+	}
+
+	site, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "deployed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deploy, err := client.CreateDigestDeploy(ctx, site.Id, digest, false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deploy.Required) != 3 {
+		t.Fatalf("expected 3 distinct files to upload, got %v", deploy.Required)
+	}
+	deploy, err = client.WaitForDeploy(ctx, deploy.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deploy.PublishedAt == "" {
+		t.Fatalf("expected a published deploy, got %+v", deploy)
+	}
+
+	// Deploying the same content again uploads nothing.
+	var uploads int
+	second, err := client.CreateDigestDeploy(ctx, site.Id, digest, true, "again")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, req := range srv.Requests() {
+		if strings.HasPrefix(req, "PUT deploys/"+second.Id+"/") {
+			uploads++
+		}
+	}
+	if len(second.Required) != 0 || uploads != 0 {
+		t.Fatalf("expected no upload, got %d", uploads)
+	}
+}
+
+func TestZipDeploy(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	site, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "zipped"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	f, err := archive.Create("index.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte("<h1>Hello</h1>")); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	deploy, err := client.CreateZipDeploy(ctx, site.Id, buf.Bytes(), true, "zipped")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deploy.Title != "zipped" || deploy.Context != "draft" {
+		t.Fatalf("unexpected deploy %+v", deploy)
+	}
+	deploy, err = client.WaitForDeploy(ctx, deploy.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deploy.State != "ready" || deploy.PublishedAt != "" {
+		t.Fatalf("expected an unpublished ready deploy, got %+v", deploy)
+	}
+
+	_, err = client.CreateZipDeploy(ctx, site.Id, []byte("not a zip"), false, "")
+	if !netlify.IsUnprocessable(err) {
+		t.Fatalf("expected an unprocessable error, got %v", err)
+	}
+}
+
+func TestRestoreAndLockDeploy(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	site, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "pinned"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var deployIds []string
+	for _, content := range []string{"v1", "v2"} {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		digest, err := netlify.DigestDirectory(dir, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		deploy, err := client.CreateDigestDeploy(ctx, site.Id, digest, false, content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.WaitForDeploy(ctx, deploy.Id); err != nil {
+			t.Fatal(err)
+		}
+		deployIds = append(deployIds, deploy.Id)
+	}
+
+	if _, err := client.RestoreSiteDeploy(ctx, site.Id, deployIds[0]); err != nil {
+		t.Fatal(err)
+	}
+	deploy, err := client.LockDeploy(ctx, deployIds[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !deploy.Locked {
+		t.Fatal("expected the deploy to be locked")
+	}
+
+	site, err = client.GetSite(ctx, site.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if site.PublishedDeploy == nil || site.PublishedDeploy.Id != deployIds[0] || !site.PublishedDeploy.Locked {
+		t.Fatalf("expected the first deploy to be published and locked, got %+v", site.PublishedDeploy)
+	}
+
+	// Only the published deploy can be locked.
+	_, err = client.LockDeploy(ctx, deployIds[1])
+	if !netlify.IsUnprocessable(err) {
+		t.Fatalf("expected an unprocessable error, got %v", err)
+	}
+
+	deploy, err = client.UnlockDeploy(ctx, deployIds[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deploy.Locked {
+		t.Fatal("expected the deploy to be unlocked")
+	}
+}
+
+func TestListDeploys(t *testing.T) {
+	ctx := context.Background()
+	client, srv := newTestClient(t)
+
+	site, err := client.CreateSite(ctx, netlify.SiteRequest{Name: "listed", Repo: &netlify.Repository{Branch: "main"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lastDeployId string
+	for i := 0; i < 3; i++ {
+		build, err := client.CreateSiteBuild(ctx, site.Id, netlify.BuildRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.WaitForBuild(ctx, build.Id); err != nil {
+			t.Fatal(err)
+		}
+		lastDeployId = build.DeployId
+	}
+
+	production := true
+	deploys, err := client.ListDeploys(ctx, site.Id, netlify.ListDeploysParams{State: "ready", Branch: "main", Production: &production}, netlify.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deploys) != 3 || deploys[0].Id != lastDeployId {
+		t.Fatalf("expected the 3 deploys, most recent first, got %+v", deploys)
+	}
+
+	deploys, err = client.ListDeploys(ctx, site.Id, netlify.ListDeploysParams{Branch: "develop"}, netlify.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deploys) != 0 {
+		t.Fatalf("expected no deploy, got %d", len(deploys))
+	}
+
+	before := len(srv.Requests())
+	deploys, err = client.ListDeploys(ctx, site.Id, netlify.ListDeploysParams{Context: "production"}, netlify.ListOptions{PerPage: 1, MaxItems: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deploys) != 2 || deploys[0].Id != lastDeployId {
+		t.Fatalf("expected the 2 most recent production deploys, got %+v", deploys)
+	}
+	if got := len(srv.Requests()) - before; got != 2 {
+		t.Fatalf("expected to stop after 2 pages, got %d requests", got)
+	}
+
+	deploys, err = client.ListDeploys(ctx, site.Id, netlify.ListDeploysParams{Context: "deploy-preview"}, netlify.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deploys) != 0 {
+		t.Fatalf("expected no deploy preview, got %d", len(deploys))
+	}
+}
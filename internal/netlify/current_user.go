@@ -2,6 +2,7 @@ package netlify
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 )
 
@@ -18,7 +19,7 @@ type CurrentUser struct {
 	LastLogin   string `json:"last_login"`
 }
 
-func (c *NetlifyClient) GetCurrentUser() (*CurrentUser, error) {
+func (c *NetlifyClient) GetCurrentUser(ctx context.Context) (*CurrentUser, error) {
 	var currentUser CurrentUser
 
 	reqDo := Request{
@@ -26,7 +27,7 @@ func (c *NetlifyClient) GetCurrentUser() (*CurrentUser, error) {
 		Path:   "user/",
 		Body:   &bytes.Buffer{},
 	}
-	err := c.Do(reqDo, &currentUser)
+	err := c.Do(ctx, reqDo, &currentUser)
 	if err != nil {
 		return nil, err
 	}
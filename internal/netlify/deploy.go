@@ -0,0 +1,286 @@
+package netlify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type Deploy struct {
+	Id           string `json:"id"`
+	SiteId       string `json:"site_id"`
+	BuildId      string `json:"build_id"`
+	State        string `json:"state"`
+	Name         string `json:"name"`
+	Url          string `json:"url"`
+	SslUrl       string `json:"ssl_url"`
+	AdminUrl     string `json:"admin_url"`
+	DeployUrl    string `json:"deploy_url"`
+	DeploySslUrl string `json:"deploy_ssl_url"`
+	Branch       string `json:"branch"`
+	CommitRef    string `json:"commit_ref"`
+	CommitUrl    string `json:"commit_url"`
+	Context      string `json:"context"`
+	Title        string `json:"title"`
+	ErrorMessage string `json:"error_message"`
+	Locked       bool   `json:"locked"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+	PublishedAt  string `json:"published_at"`
+
+	// Required lists the SHA1 digests of the files of a new deploy that
+	// Netlify does not have yet and that have to be uploaded.
+	Required []string `json:"required"`
+	// RequiredFunctions lists the SHA256 digests of the functions to upload.
+	RequiredFunctions []string `json:"required_functions"`
+}
+
+// DeployRequest creates a deploy from digests. Files maps deploy paths, such
+// as /index.html, to SHA1 digests and Functions maps function names to the
+// SHA256 digests of their zip archives.
+type DeployRequest struct {
+	Files     map[string]string `json:"files"`
+	Functions map[string]string `json:"functions,omitempty"`
+	Draft     bool              `json:"draft,omitempty"`
+	Title     string            `json:"title,omitempty"`
+}
+
+// ListDeploysParams filters the deploys returned by ListDeploys.
+type ListDeploysParams struct {
+	// State only keeps deploys in the given state, e.g. ready or error.
+	State string
+	// Branch only keeps deploys of the given branch.
+	Branch string
+	// Production only keeps production deploys when true, and the other
+	// deploys when false.
+	Production *bool
+	// Context only keeps deploys of the given context, e.g. production or
+	// deploy-preview. The API has no such filter, so deploys are filtered as
+	// pages are fetched, narrowed down by Production when it is not set.
+	Context string
+}
+
+// ListDeploys lists the deploys of a site, most recent first. With a context
+// filter, opts.MaxItems bounds the deploys matching it rather than the ones
+// fetched.
+func (c *NetlifyClient) ListDeploys(ctx context.Context, siteId string, params ListDeploysParams, opts ListOptions) ([]Deploy, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "sites/" + siteId + "/deploys",
+		Body:   &bytes.Buffer{},
+		Query:  map[string]string{},
+	}
+	if params.State != "" {
+		reqDo.Query["state"] = params.State
+	}
+	if params.Branch != "" {
+		reqDo.Query["branch"] = params.Branch
+	}
+	if params.Production != nil {
+		reqDo.Query["production"] = strconv.FormatBool(*params.Production)
+	}
+	if params.Context == "" {
+		return listAll[Deploy](ctx, c, reqDo, opts)
+	}
+
+	if params.Production == nil {
+		reqDo.Query["production"] = strconv.FormatBool(params.Context == "production")
+	}
+	paginator := NewPaginator[Deploy](c, reqDo, ListOptions{PerPage: opts.PerPage})
+	var deploys []Deploy
+	for paginator.HasNext() {
+		page, err := paginator.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, deploy := range page {
+			if deploy.Context != params.Context {
+				continue
+			}
+			deploys = append(deploys, deploy)
+			if opts.MaxItems > 0 && len(deploys) == opts.MaxItems {
+				return deploys, nil
+			}
+		}
+	}
+	return deploys, nil
+}
+
+// CreateSiteDeploy creates a deploy from digests. The files and functions
+// listed as required by the returned deploy have to be uploaded before it
+// gets processed.
+func (c *NetlifyClient) CreateSiteDeploy(ctx context.Context, siteId string, deploy DeployRequest) (*Deploy, error) {
+	jsonValue, err := json.Marshal(deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	reqDo := Request{
+		Method: http.MethodPost,
+		Path:   "sites/" + siteId + "/deploys",
+		Body:   bytes.NewBuffer(jsonValue),
+	}
+
+	var res Deploy
+	err = c.Do(ctx, reqDo, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CreateZipDeploy creates a deploy from a zip archive of the files to
+// publish. Unlike digest deploys, the whole archive is uploaded.
+func (c *NetlifyClient) CreateZipDeploy(ctx context.Context, siteId string, archive []byte, draft bool, title string) (*Deploy, error) {
+	query := map[string]string{}
+	if draft {
+		query["draft"] = "true"
+	}
+	if title != "" {
+		query["title"] = title
+	}
+
+	reqDo := Request{
+		Method:      http.MethodPost,
+		Path:        "sites/" + siteId + "/deploys",
+		Query:       query,
+		Body:        bytes.NewBuffer(archive),
+		ContentType: "application/zip",
+	}
+
+	var res Deploy
+	err := c.Do(ctx, reqDo, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UploadDeployFile uploads the content of the file deployed at path, e.g.
+// /index.html.
+func (c *NetlifyClient) UploadDeployFile(ctx context.Context, deployId string, path string, content []byte) error {
+	reqDo := Request{
+		Method:      http.MethodPut,
+		Path:        "deploys/" + deployId + "/files" + escapePath(path),
+		Body:        bytes.NewBuffer(content),
+		ContentType: "application/octet-stream",
+	}
+	return c.Do(ctx, reqDo, nil)
+}
+
+// UploadDeployFunction uploads the zip archive of a function.
+func (c *NetlifyClient) UploadDeployFunction(ctx context.Context, deployId string, name string, archive []byte) error {
+	reqDo := Request{
+		Method:      http.MethodPut,
+		Path:        "deploys/" + deployId + "/functions/" + url.PathEscape(name),
+		Query:       map[string]string{"runtime": "js"},
+		Body:        bytes.NewBuffer(archive),
+		ContentType: "application/octet-stream",
+	}
+	return c.Do(ctx, reqDo, nil)
+}
+
+func (c *NetlifyClient) GetDeploy(ctx context.Context, deployId string) (*Deploy, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "deploys/" + deployId,
+		Body:   &bytes.Buffer{},
+	}
+
+	var deploy Deploy
+	err := c.Do(ctx, reqDo, &deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deploy, nil
+}
+
+// RestoreSiteDeploy publishes a previous deploy of a site, rolling the site
+// back to it.
+func (c *NetlifyClient) RestoreSiteDeploy(ctx context.Context, siteId string, deployId string) (*Deploy, error) {
+	reqDo := Request{
+		Method: http.MethodPost,
+		Path:   "sites/" + siteId + "/deploys/" + deployId + "/restore",
+		Body:   &bytes.Buffer{},
+	}
+
+	var deploy Deploy
+	err := c.Do(ctx, reqDo, &deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deploy, nil
+}
+
+// LockDeploy locks a published deploy, stopping the new deploys of the site
+// from being published automatically.
+func (c *NetlifyClient) LockDeploy(ctx context.Context, deployId string) (*Deploy, error) {
+	return c.setDeployLock(ctx, deployId, "lock")
+}
+
+// UnlockDeploy unlocks a deploy, resuming auto publishing.
+func (c *NetlifyClient) UnlockDeploy(ctx context.Context, deployId string) (*Deploy, error) {
+	return c.setDeployLock(ctx, deployId, "unlock")
+}
+
+func (c *NetlifyClient) setDeployLock(ctx context.Context, deployId string, action string) (*Deploy, error) {
+	reqDo := Request{
+		Method: http.MethodPost,
+		Path:   "deploys/" + deployId + "/" + action,
+		Body:   &bytes.Buffer{},
+	}
+
+	var deploy Deploy
+	err := c.Do(ctx, reqDo, &deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deploy, nil
+}
+
+// WaitForDeploy polls a deploy until it is ready to be served.
+func (c *NetlifyClient) WaitForDeploy(ctx context.Context, deployId string) (*Deploy, error) {
+	var deploy *Deploy
+	err := c.poll(ctx, func() (bool, error) {
+		var err error
+		deploy, err = c.GetDeploy(ctx, deployId)
+		if err != nil {
+			return false, err
+		}
+		switch deploy.State {
+		case "ready":
+			return true, nil
+		case "error":
+			msg := fmt.Sprintf("deploy %s failed", deployId)
+			if deploy.ErrorMessage != "" {
+				msg += ": " + deploy.ErrorMessage
+			}
+			return false, errors.New(msg)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deploy, nil
+}
+
+// escapePath escapes each segment of a slash separated path.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,231 @@
+package netlify
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// uploadConcurrency is the number of files uploaded at the same time.
+const uploadConcurrency = 8
+
+// DeployDigest describes the content of a local directory as expected by
+// the file digest deploy API.
+type DeployDigest struct {
+	// Files maps deploy paths to SHA1 digests.
+	Files map[string]string
+	// Functions maps function names to the SHA256 digests of their archives.
+	Functions map[string]string
+	// Hash identifies the whole content, it changes whenever a file or a
+	// function is added, removed or modified.
+	Hash string
+
+	// sources maps digests to the local files holding the content.
+	sources map[string]string
+}
+
+// DigestDirectory computes the digests of the files of dir and, unless
+// empty, of the function archives of functionsDir. Hidden files are skipped,
+// except for the .well-known directory. Functions are the zip archives at the
+// top of functionsDir, named after the archive.
+func DigestDirectory(dir string, functionsDir string) (*DeployDigest, error) {
+	digest := &DeployDigest{
+		Files:     map[string]string{},
+		Functions: map[string]string{},
+		sources:   map[string]string{},
+	}
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(entry.Name(), ".") && entry.Name() != ".well-known" {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		sum, err := fileDigest(path, sha1.New())
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		digest.Files["/"+filepath.ToSlash(rel)] = sum
+		digest.sources[sum] = path
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if functionsDir != "" {
+		entries, err := os.ReadDir(functionsDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".zip" {
+				continue
+			}
+			path := filepath.Join(functionsDir, entry.Name())
+			sum, err := fileDigest(path, sha256.New())
+			if err != nil {
+				return nil, err
+			}
+			digest.Functions[strings.TrimSuffix(entry.Name(), ".zip")] = sum
+			digest.sources[sum] = path
+		}
+	}
+
+	digest.Hash = digest.hash()
+	return digest, nil
+}
+
+func (d *DeployDigest) hash() string {
+	var lines []string
+	for path, sum := range d.Files {
+		lines = append(lines, "file "+path+" "+sum)
+	}
+	for name, sum := range d.Functions {
+		lines = append(lines, "function "+name+" "+sum)
+	}
+	sort.Strings(lines)
+
+	h := sha256.New()
+	for _, line := range lines {
+		_, _ = io.WriteString(h, line+"\n")
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func fileDigest(path string, h hash.Hash) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// CreateDigestDeploy creates a deploy of the content described by digest
+// and uploads the files and functions Netlify does not have yet. The deploy
+// is then processed by Netlify, see WaitForDeploy.
+func (c *NetlifyClient) CreateDigestDeploy(ctx context.Context, siteId string, digest *DeployDigest, draft bool, title string) (*Deploy, error) {
+	deploy, err := c.CreateSiteDeploy(ctx, siteId, DeployRequest{
+		Files:     digest.Files,
+		Functions: digest.Functions,
+		Draft:     draft,
+		Title:     title,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Several files may share a digest, one upload is enough for all of
+	// them.
+	paths := map[string]string{}
+	for path, sum := range digest.Files {
+		paths[sum] = path
+	}
+	names := map[string]string{}
+	for name, sum := range digest.Functions {
+		names[sum] = name
+	}
+
+	var uploads []func(ctx context.Context) error
+	for _, sum := range deploy.Required {
+		sum := sum
+		path, ok := paths[sum]
+		if !ok {
+			return nil, fmt.Errorf("deploy %s requires unknown file %s", deploy.Id, sum)
+		}
+		uploads = append(uploads, func(ctx context.Context) error {
+			content, err := os.ReadFile(digest.sources[sum])
+			if err != nil {
+				return err
+			}
+			return c.UploadDeployFile(ctx, deploy.Id, path, content)
+		})
+	}
+	for _, sum := range deploy.RequiredFunctions {
+		sum := sum
+		name, ok := names[sum]
+		if !ok {
+			return nil, fmt.Errorf("deploy %s requires unknown function %s", deploy.Id, sum)
+		}
+		uploads = append(uploads, func(ctx context.Context) error {
+			archive, err := os.ReadFile(digest.sources[sum])
+			if err != nil {
+				return err
+			}
+			return c.UploadDeployFunction(ctx, deploy.Id, name, archive)
+		})
+	}
+
+	if err := runConcurrently(ctx, uploadConcurrency, uploads); err != nil {
+		return nil, err
+	}
+	return deploy, nil
+}
+
+// runConcurrently runs tasks, at most limit at a time. The first error
+// cancels the remaining tasks and is returned.
+func runConcurrently(ctx context.Context, limit int, tasks []func(ctx context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, limit)
+	for _, task := range tasks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(task func(ctx context.Context) error) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := task(ctx); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(task)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
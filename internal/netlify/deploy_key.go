@@ -2,6 +2,7 @@ package netlify
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 )
 
@@ -11,7 +12,7 @@ type DeployKey struct {
 	CreatedAt string `json:"created_at"`
 }
 
-func (c *NetlifyClient) CreateDeployKey() (*DeployKey, error) {
+func (c *NetlifyClient) CreateDeployKey(ctx context.Context) (*DeployKey, error) {
 	var key DeployKey
 
 	reqDo := Request{
@@ -19,14 +20,14 @@ func (c *NetlifyClient) CreateDeployKey() (*DeployKey, error) {
 		Path:   "deploy_keys/",
 		Body:   &bytes.Buffer{},
 	}
-	err := c.Do(reqDo, &key)
+	err := c.Do(ctx, reqDo, &key)
 	if err != nil {
 		return nil, err
 	}
 	return &key, nil
 }
 
-func (c *NetlifyClient) GetDeployKey(keyId string) (*DeployKey, error) {
+func (c *NetlifyClient) GetDeployKey(ctx context.Context, keyId string) (*DeployKey, error) {
 	var key DeployKey
 
 	reqDo := Request{
@@ -34,18 +35,18 @@ func (c *NetlifyClient) GetDeployKey(keyId string) (*DeployKey, error) {
 		Path:   "deploy_keys/" + keyId,
 		Body:   &bytes.Buffer{},
 	}
-	err := c.Do(reqDo, &key)
+	err := c.Do(ctx, reqDo, &key)
 	if err != nil {
 		return nil, err
 	}
 	return &key, nil
 }
 
-func (c *NetlifyClient) DeleteDeployKey(keyId string) error {
+func (c *NetlifyClient) DeleteDeployKey(ctx context.Context, keyId string) error {
 	reqDo := Request{
 		Method: http.MethodDelete,
 		Path:   "deploy_keys/" + keyId,
 		Body:   &bytes.Buffer{},
 	}
-	return c.Do(reqDo, nil)
+	return c.Do(ctx, reqDo, nil)
 }
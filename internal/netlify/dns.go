@@ -0,0 +1,175 @@
+package netlify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// DNSZone is a domain whose DNS is hosted by Netlify.
+type DNSZone struct {
+	Id                   string   `json:"id"`
+	Name                 string   `json:"name"`
+	AccountSlug          string   `json:"account_slug"`
+	SiteId               string   `json:"site_id"`
+	DNSServers           []string `json:"dns_servers"`
+	SupportedRecordTypes []string `json:"supported_record_types"`
+	CreatedAt            string   `json:"created_at"`
+	UpdatedAt            string   `json:"updated_at"`
+}
+
+type DNSZoneRequest struct {
+	AccountSlug string `json:"account_slug,omitempty"`
+	SiteId      string `json:"site_id,omitempty"`
+	Name        string `json:"name"`
+}
+
+// DNSRecord is a record of a zone. Records cannot be modified, only deleted
+// and created again.
+type DNSRecord struct {
+	Id        string `json:"id"`
+	Hostname  string `json:"hostname"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	TTL       int64  `json:"ttl"`
+	Priority  int64  `json:"priority"`
+	Weight    int64  `json:"weight"`
+	Port      int64  `json:"port"`
+	Flag      int64  `json:"flag"`
+	Tag       string `json:"tag"`
+	DNSZoneId string `json:"dns_zone_id"`
+	SiteId    string `json:"site_id"`
+	Managed   bool   `json:"managed"`
+}
+
+// DNSRecordRequest creates a record. Priority is only used by MX and SRV
+// records, weight and port by SRV records and flag and tag by CAA records.
+type DNSRecordRequest struct {
+	Type     string `json:"type"`
+	Hostname string `json:"hostname"`
+	Value    string `json:"value"`
+	TTL      int64  `json:"ttl,omitempty"`
+	Priority *int64 `json:"priority,omitempty"`
+	Weight   *int64 `json:"weight,omitempty"`
+	Port     *int64 `json:"port,omitempty"`
+	Flag     *int64 `json:"flag,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+}
+
+func (c *NetlifyClient) CreateDNSZone(ctx context.Context, req DNSZoneRequest) (*DNSZone, error) {
+	jsonValue, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	reqDo := Request{
+		Method: http.MethodPost,
+		Path:   "dns_zones",
+		Body:   bytes.NewBuffer(jsonValue),
+	}
+
+	var zone DNSZone
+	err = c.Do(ctx, reqDo, &zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &zone, nil
+}
+
+func (c *NetlifyClient) GetDNSZone(ctx context.Context, zoneId string) (*DNSZone, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "dns_zones/" + zoneId,
+		Body:   &bytes.Buffer{},
+	}
+
+	var zone DNSZone
+	err := c.Do(ctx, reqDo, &zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &zone, nil
+}
+
+// DeleteDNSZone deletes a zone along with all its records.
+func (c *NetlifyClient) DeleteDNSZone(ctx context.Context, zoneId string) error {
+	reqDo := Request{
+		Method: http.MethodDelete,
+		Path:   "dns_zones/" + zoneId,
+		Body:   &bytes.Buffer{},
+	}
+	return c.Do(ctx, reqDo, nil)
+}
+
+// ListDNSZones lists the zones of an account, or of every account of the user
+// when accountSlug is empty.
+func (c *NetlifyClient) ListDNSZones(ctx context.Context, accountSlug string, opts ListOptions) ([]DNSZone, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "dns_zones",
+		Body:   &bytes.Buffer{},
+		Query:  map[string]string{},
+	}
+	if accountSlug != "" {
+		reqDo.Query["account_slug"] = accountSlug
+	}
+	return listAll[DNSZone](ctx, c, reqDo, opts)
+}
+
+func (c *NetlifyClient) CreateDNSRecord(ctx context.Context, zoneId string, req DNSRecordRequest) (*DNSRecord, error) {
+	jsonValue, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	reqDo := Request{
+		Method: http.MethodPost,
+		Path:   "dns_zones/" + zoneId + "/dns_records",
+		Body:   bytes.NewBuffer(jsonValue),
+	}
+
+	var record DNSRecord
+	err = c.Do(ctx, reqDo, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func (c *NetlifyClient) GetDNSRecord(ctx context.Context, zoneId string, recordId string) (*DNSRecord, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "dns_zones/" + zoneId + "/dns_records/" + recordId,
+		Body:   &bytes.Buffer{},
+	}
+
+	var record DNSRecord
+	err := c.Do(ctx, reqDo, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func (c *NetlifyClient) DeleteDNSRecord(ctx context.Context, zoneId string, recordId string) error {
+	reqDo := Request{
+		Method: http.MethodDelete,
+		Path:   "dns_zones/" + zoneId + "/dns_records/" + recordId,
+		Body:   &bytes.Buffer{},
+	}
+	return c.Do(ctx, reqDo, nil)
+}
+
+func (c *NetlifyClient) ListDNSRecords(ctx context.Context, zoneId string, opts ListOptions) ([]DNSRecord, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "dns_zones/" + zoneId + "/dns_records",
+		Body:   &bytes.Buffer{},
+	}
+	return listAll[DNSRecord](ctx, c, reqDo, opts)
+}
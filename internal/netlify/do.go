@@ -2,12 +2,14 @@ package netlify
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Request struct {
@@ -15,12 +17,28 @@ type Request struct {
 	Path   string
 	Query  map[string]string
 	Body   *bytes.Buffer
+	// ContentType of the body, JSON when empty.
+	ContentType string
+	// Secrets lists values sent or received by the request that must never
+	// show up in logs.
+	Secrets []string
+	// RedactResponse rewrites the response body before it is logged in debug
+	// mode, hiding secrets that are only known once the response is read.
+	RedactResponse func(body []byte) []byte
+}
+
+// Do sends req to the Netlify API and decodes the JSON response into dest,
+// unless dest is nil.
+func (c *NetlifyClient) Do(ctx context.Context, req Request, dest any) error {
+	_, err := c.do(ctx, req, dest)
+	return err
 }
 
-func (c *NetlifyClient) Do(req Request, dest any) error {
+// do is Do, also returning the response headers.
+func (c *NetlifyClient) do(ctx context.Context, req Request, dest any) (http.Header, error) {
 	reqURL, err := url.Parse(c.BaseURL.String() + req.Path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(req.Query) > 0 {
@@ -31,35 +49,89 @@ func (c *NetlifyClient) Do(req Request, dest any) error {
 		}
 	}
 
-	log.Println(reqURL.String())
-	log.Println(req.Body.String())
-	httpReq, err := http.NewRequest(req.Method, reqURL.String(), req.Body)
-	if err != nil {
-		return err
+	// Keep the body around so that it can be sent again on retries.
+	var body []byte
+	if req.Body != nil {
+		body = req.Body.Bytes()
 	}
 
-	res, err := c.HTTPClient.Do(httpReq)
-	if err != nil {
-		return err
+	ctx = newLogContext(ctx, req.Secrets, req.RedactResponse)
+
+	var res *http.Response
+	for attempt := 0; ; attempt++ {
+		if err := sleep(ctx, c.rateLimit.wait()); err != nil {
+			return nil, err
+		}
+
+		httpReq, err := http.NewRequestWithContext(ctx, req.Method, reqURL.String(), bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		if req.ContentType != "" {
+			httpReq.Header.Set("Content-Type", req.ContentType)
+		}
+
+		res, err = c.HTTPClient.Do(httpReq)
+		if res != nil {
+			c.rateLimit.update(res)
+		}
+		if attempt >= c.MaxRetries || ctx.Err() != nil || !shouldRetry(req.Method, res, err) {
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		wait := c.backoff(attempt, res)
+		if res != nil {
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		tflog.SubsystemWarn(ctx, logSubsystem, "Retrying Netlify API call", map[string]any{
+			"http_method": req.Method,
+			"http_path":   reqURL.Path,
+			"attempt":     attempt + 1,
+			"max_retries": c.MaxRetries,
+			"wait":        wait.String(),
+		})
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
 
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusNoContent {
-		resErr, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("invalid status code received %d : %s", res.StatusCode, resErr)
+		return nil, newAPIError(res)
 	}
 
 	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if dest != nil {
 		err = json.Unmarshal(resBody, dest)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return res.Header, nil
+}
+
+// sleep waits for d, returning early with the context error if ctx is done
+// first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
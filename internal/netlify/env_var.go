@@ -2,6 +2,7 @@ package netlify
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 )
@@ -20,7 +21,7 @@ type EnvVarValue struct {
 	ContextParameter string `json:"context_parameter,omitempty"`
 }
 
-func (c *NetlifyClient) CreateEnvVar(accountSlug string, siteId string, envVar EnvVar) (*EnvVar, error) {
+func (c *NetlifyClient) CreateEnvVar(ctx context.Context, accountSlug string, siteId string, envVar EnvVar) (*EnvVar, error) {
 	jsonValue, err := json.Marshal([]EnvVar{envVar})
 	if err != nil {
 		return nil, err
@@ -33,10 +34,12 @@ func (c *NetlifyClient) CreateEnvVar(accountSlug string, siteId string, envVar E
 		Query: map[string]string{
 			"site_id": siteId,
 		},
+		Secrets:        envVarSecrets(envVar),
+		RedactResponse: redactEnvVarValues,
 	}
 
 	var resEnvVars []EnvVar
-	err = c.Do(reqDo, &resEnvVars)
+	err = c.Do(ctx, reqDo, &resEnvVars)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +47,7 @@ func (c *NetlifyClient) CreateEnvVar(accountSlug string, siteId string, envVar E
 	return &resEnvVars[0], nil
 }
 
-func (c *NetlifyClient) GetEnvVar(accountSlug string, siteId string, key string) (*EnvVar, error) {
+func (c *NetlifyClient) GetEnvVar(ctx context.Context, accountSlug string, siteId string, key string) (*EnvVar, error) {
 	reqDo := Request{
 		Method: http.MethodGet,
 		Path:   "accounts/" + accountSlug + "/env/" + key,
@@ -52,17 +55,18 @@ func (c *NetlifyClient) GetEnvVar(accountSlug string, siteId string, key string)
 		Query: map[string]string{
 			"site_id": siteId,
 		},
+		RedactResponse: redactEnvVarValues,
 	}
 
 	var resEnvVar EnvVar
-	err := c.Do(reqDo, &resEnvVar)
+	err := c.Do(ctx, reqDo, &resEnvVar)
 	if err != nil {
 		return nil, err
 	}
 	return &resEnvVar, nil
 }
 
-func (c *NetlifyClient) UpdateEnvVar(accountSlug string, siteId string, key string, envVar EnvVar) (*EnvVar, error) {
+func (c *NetlifyClient) UpdateEnvVar(ctx context.Context, accountSlug string, siteId string, key string, envVar EnvVar) (*EnvVar, error) {
 	jsonValue, err := json.Marshal(envVar)
 	if err != nil {
 		return nil, err
@@ -75,10 +79,12 @@ func (c *NetlifyClient) UpdateEnvVar(accountSlug string, siteId string, key stri
 		Query: map[string]string{
 			"site_id": siteId,
 		},
+		Secrets:        envVarSecrets(envVar),
+		RedactResponse: redactEnvVarValues,
 	}
 
 	var resEnvVars EnvVar
-	err = c.Do(reqDo, &resEnvVars)
+	err = c.Do(ctx, reqDo, &resEnvVars)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +92,48 @@ func (c *NetlifyClient) UpdateEnvVar(accountSlug string, siteId string, key stri
 	return &resEnvVars, nil
 }
 
-func (c *NetlifyClient) DeleteEnvVar(accountSlug string, siteId string, key string) error {
+func (c *NetlifyClient) SetEnvVarValue(ctx context.Context, accountSlug string, siteId string, key string, value EnvVarValue) (*EnvVar, error) {
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	reqDo := Request{
+		Method: http.MethodPatch,
+		Path:   "accounts/" + accountSlug + "/env/" + key,
+		Body:   bytes.NewBuffer(jsonValue),
+		Query: map[string]string{
+			"site_id": siteId,
+		},
+		// The variable is not known here, so the value is always treated as
+		// a secret.
+		Secrets:        []string{value.Value},
+		RedactResponse: redactEnvVarValues,
+	}
+
+	var resEnvVar EnvVar
+	err = c.Do(ctx, reqDo, &resEnvVar)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resEnvVar, nil
+}
+
+func (c *NetlifyClient) DeleteEnvVarValue(ctx context.Context, accountSlug string, siteId string, key string, valueId string) error {
+	reqDo := Request{
+		Method: http.MethodDelete,
+		Path:   "accounts/" + accountSlug + "/env/" + key + "/value/" + valueId,
+		Body:   &bytes.Buffer{},
+		Query: map[string]string{
+			"site_id": siteId,
+		},
+	}
+
+	return c.Do(ctx, reqDo, nil)
+}
+
+func (c *NetlifyClient) DeleteEnvVar(ctx context.Context, accountSlug string, siteId string, key string) error {
 	reqDo := Request{
 		Method: http.MethodDelete,
 		Path:   "accounts/" + accountSlug + "/env/" + key,
@@ -96,5 +143,46 @@ func (c *NetlifyClient) DeleteEnvVar(accountSlug string, siteId string, key stri
 		},
 	}
 
-	return c.Do(reqDo, nil)
+	return c.Do(ctx, reqDo, nil)
+}
+
+// envVarSecrets returns the values of envVar that must be redacted from logs.
+func envVarSecrets(envVar EnvVar) []string {
+	if !envVar.IsSecret {
+		return nil
+	}
+	var secrets []string
+	for _, value := range envVar.Values {
+		secrets = append(secrets, value.Value)
+	}
+	return secrets
+}
+
+// redactEnvVarValues hides the values of the secret variables of an env var
+// response body, which are not known before the response is read.
+func redactEnvVarValues(body []byte) []byte {
+	return redactJSONObjects(body, func(envVar map[string]any) {
+		if isSecret, _ := envVar["is_secret"].(bool); !isSecret {
+			return
+		}
+		values, _ := envVar["values"].([]any)
+		for _, value := range values {
+			if value, ok := value.(map[string]any); ok {
+				value["value"] = redacted
+			}
+		}
+	})
+}
+
+func (c *NetlifyClient) ListEnvVars(ctx context.Context, accountSlug string, siteId string, opts ListOptions) ([]EnvVar, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "accounts/" + accountSlug + "/env",
+		Body:   &bytes.Buffer{},
+		Query: map[string]string{
+			"site_id": siteId,
+		},
+		RedactResponse: redactEnvVarValues,
+	}
+	return listAll[EnvVar](ctx, c, reqDo, opts)
 }
@@ -0,0 +1,81 @@
+package netlify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned by the client when Netlify answers with a non
+// successful status code.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestId  string
+	// Body holds the decoded JSON body of the response, if any.
+	Body map[string]any
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("netlify API returned status code %d", e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RequestId != "" {
+		msg += " (request id " + e.RequestId + ")"
+	}
+	return msg
+}
+
+func newAPIError(res *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		RequestId:  res.Header.Get("X-Nf-Request-Id"),
+	}
+	if apiErr.RequestId == "" {
+		apiErr.RequestId = res.Header.Get("X-Request-Id")
+	}
+
+	raw, _ := io.ReadAll(res.Body)
+	if err := json.Unmarshal(raw, &apiErr.Body); err != nil {
+		apiErr.Message = strings.TrimSpace(string(raw))
+		return apiErr
+	}
+
+	if code, ok := apiErr.Body["code"]; ok {
+		apiErr.Code = fmt.Sprint(code)
+	}
+	for _, key := range []string{"message", "error", "errors"} {
+		if message, ok := apiErr.Body[key]; ok {
+			apiErr.Message = fmt.Sprint(message)
+			break
+		}
+	}
+	return apiErr
+}
+
+// IsNotFound reports whether err is an APIError with a 404 status code.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an APIError with a 401 or 403 status
+// code.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized) || hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsUnprocessable reports whether err is an APIError with a 422 status code,
+// which Netlify uses for validation errors.
+func IsUnprocessable(err error) bool {
+	return hasStatusCode(err, http.StatusUnprocessableEntity)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
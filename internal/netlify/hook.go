@@ -0,0 +1,141 @@
+package netlify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Hook notifies a URL, a Slack channel or an email address of the events of
+// a site, such as deploy_failed.
+type Hook struct {
+	Id        string         `json:"id"`
+	SiteId    string         `json:"site_id"`
+	Type      string         `json:"type"`
+	Event     string         `json:"event"`
+	Data      map[string]any `json:"data"`
+	Disabled  bool           `json:"disabled"`
+	CreatedAt string         `json:"created_at"`
+	UpdatedAt string         `json:"updated_at"`
+}
+
+type HookRequest struct {
+	Type  string            `json:"type"`
+	Event string            `json:"event"`
+	Data  map[string]string `json:"data"`
+}
+
+// HookType describes the events a type of hook can be registered for, and
+// the fields of its data.
+type HookType struct {
+	Name   string          `json:"name"`
+	Events []string        `json:"events"`
+	Fields []HookTypeField `json:"fields"`
+}
+
+type HookTypeField struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+}
+
+func (c *NetlifyClient) CreateHook(ctx context.Context, siteId string, req HookRequest) (*Hook, error) {
+	jsonValue, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	reqDo := Request{
+		Method: http.MethodPost,
+		Path:   "hooks",
+		Query:  map[string]string{"site_id": siteId},
+		Body:   bytes.NewBuffer(jsonValue),
+	}
+
+	var hook Hook
+	err = c.Do(ctx, reqDo, &hook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hook, nil
+}
+
+func (c *NetlifyClient) GetHook(ctx context.Context, hookId string) (*Hook, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "hooks/" + hookId,
+		Body:   &bytes.Buffer{},
+	}
+
+	var hook Hook
+	err := c.Do(ctx, reqDo, &hook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hook, nil
+}
+
+func (c *NetlifyClient) UpdateHook(ctx context.Context, hookId string, req HookRequest) (*Hook, error) {
+	jsonValue, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	reqDo := Request{
+		Method: http.MethodPut,
+		Path:   "hooks/" + hookId,
+		Body:   bytes.NewBuffer(jsonValue),
+	}
+
+	var hook Hook
+	err = c.Do(ctx, reqDo, &hook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hook, nil
+}
+
+func (c *NetlifyClient) DeleteHook(ctx context.Context, hookId string) error {
+	reqDo := Request{
+		Method: http.MethodDelete,
+		Path:   "hooks/" + hookId,
+		Body:   &bytes.Buffer{},
+	}
+	return c.Do(ctx, reqDo, nil)
+}
+
+func (c *NetlifyClient) ListHooks(ctx context.Context, siteId string) ([]Hook, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "hooks",
+		Query:  map[string]string{"site_id": siteId},
+		Body:   &bytes.Buffer{},
+	}
+
+	var hooks []Hook
+	err := c.Do(ctx, reqDo, &hooks)
+	if err != nil {
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+func (c *NetlifyClient) ListHookTypes(ctx context.Context) ([]HookType, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "hooks/types",
+		Body:   &bytes.Buffer{},
+	}
+
+	var types []HookType
+	err := c.Do(ctx, reqDo, &types)
+	if err != nil {
+		return nil, err
+	}
+
+	return types, nil
+}
@@ -0,0 +1,138 @@
+package netlify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const logSubsystem = "netlify"
+
+// redacted replaces sensitive values in logs.
+const redacted = "***"
+
+// redactResponseKey is the context key of the function redacting the
+// response bodies of a request.
+type redactResponseKey struct{}
+
+// newLogContext prepares ctx for logging the calls of one request, masking
+// the given secrets wherever they would appear and passing response bodies
+// through redactResponse, if set.
+func newLogContext(ctx context.Context, secrets []string, redactResponse func([]byte) []byte) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem)
+	if redactResponse != nil {
+		ctx = context.WithValue(ctx, redactResponseKey{}, redactResponse)
+	}
+
+	var nonEmpty []string
+	for _, secret := range secrets {
+		if secret != "" {
+			nonEmpty = append(nonEmpty, secret)
+		}
+	}
+	if len(nonEmpty) > 0 {
+		ctx = tflog.SubsystemMaskLogStrings(ctx, logSubsystem, nonEmpty...)
+	}
+	return ctx
+}
+
+// logRoundTrip logs the outcome of one HTTP call. In debug mode, headers and
+// bodies are logged as well, after redaction.
+func (n NetlifyTransport) logRoundTrip(req *http.Request, res *http.Response, err error, start time.Time) {
+	ctx := req.Context()
+	fields := map[string]any{
+		"http_method":      req.Method,
+		"http_path":        req.URL.Path,
+		"http_duration_ms": time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, logSubsystem, "Netlify API call failed", fields)
+		return
+	}
+
+	fields["http_status"] = res.StatusCode
+	if requestId := res.Header.Get("X-Nf-Request-Id"); requestId != "" {
+		fields["http_request_id"] = requestId
+	}
+
+	if n.Debug {
+		fields["http_request_headers"] = redactHeaders(req.Header, n.Token)
+		fields["http_response_headers"] = redactHeaders(res.Header, n.Token)
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				reqBody, _ := io.ReadAll(body)
+				fields["http_request_body"] = string(reqBody)
+				// Uploads are binary, only their size is worth logging.
+				if contentType := req.Header.Get("Content-Type"); contentType != "application/json" {
+					fields["http_request_body"] = fmt.Sprintf("%d bytes of %s", len(reqBody), contentType)
+				}
+			}
+		}
+		resBody, readErr := io.ReadAll(res.Body)
+		res.Body.Close()
+		res.Body = io.NopCloser(bytes.NewReader(resBody))
+		if readErr == nil {
+			if redactResponse, ok := ctx.Value(redactResponseKey{}).(func([]byte) []byte); ok {
+				resBody = redactResponse(resBody)
+			}
+			fields["http_response_body"] = string(resBody)
+		}
+	}
+
+	tflog.SubsystemDebug(ctx, logSubsystem, "Netlify API call", fields)
+}
+
+func redactHeaders(header http.Header, token string) map[string]string {
+	res := make(map[string]string, len(header))
+	for key, values := range header {
+		value := strings.Join(values, ", ")
+		if strings.EqualFold(key, "Authorization") || strings.EqualFold(key, "Cookie") || strings.EqualFold(key, "Set-Cookie") {
+			value = redacted
+		}
+		if token != "" {
+			value = strings.ReplaceAll(value, token, redacted)
+		}
+		res[key] = value
+	}
+	return res
+}
+
+// redactJSONObjects returns body with redact applied to the JSON object it
+// holds, or to each object of the array it holds. Bodies that are not JSON
+// are replaced altogether, as their secrets cannot be found.
+func redactJSONObjects(body []byte, redact func(object map[string]any)) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return []byte(redacted)
+	}
+
+	switch decoded := decoded.(type) {
+	case map[string]any:
+		redact(decoded)
+	case []any:
+		for _, item := range decoded {
+			if object, ok := item.(map[string]any); ok {
+				redact(object)
+			}
+		}
+	}
+
+	redactedBody, err := json.Marshal(decoded)
+	if err != nil {
+		return []byte(redacted)
+	}
+	return redactedBody
+}
@@ -0,0 +1,117 @@
+package netlify
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+const defaultPerPage = 100
+
+// ListOptions controls how list methods walk the pages of an endpoint.
+type ListOptions struct {
+	// PerPage is the page size requested from the API. Defaults to 100.
+	PerPage int
+	// MaxItems stops the listing once that many items have been collected.
+	// Zero means no limit.
+	MaxItems int
+}
+
+// Paginator walks the pages of a list endpoint following Netlify's
+// page/per_page parameters and the Link header of each response.
+type Paginator[T any] struct {
+	client *NetlifyClient
+	req    Request
+	opts   ListOptions
+
+	page  int
+	done  bool
+	count int
+}
+
+func NewPaginator[T any](client *NetlifyClient, req Request, opts ListOptions) *Paginator[T] {
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+	return &Paginator[T]{
+		client: client,
+		req:    req,
+		opts:   opts,
+		page:   1,
+	}
+}
+
+// HasNext reports whether another page may be fetched.
+func (p *Paginator[T]) HasNext() bool {
+	return !p.done
+}
+
+// Next fetches the next page.
+func (p *Paginator[T]) Next(ctx context.Context) ([]T, error) {
+	query := map[string]string{}
+	for key, value := range p.req.Query {
+		query[key] = value
+	}
+	query["page"] = strconv.Itoa(p.page)
+	query["per_page"] = strconv.Itoa(p.opts.PerPage)
+
+	req := p.req
+	req.Query = query
+
+	var items []T
+	header, err := p.client.do(ctx, req, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.opts.MaxItems > 0 && p.count+len(items) >= p.opts.MaxItems {
+		items = items[:p.opts.MaxItems-p.count]
+		p.done = true
+	}
+	p.count += len(items)
+
+	next, ok := nextPage(header)
+	if !ok || len(items) == 0 {
+		p.done = true
+	}
+	p.page = next
+	return items, nil
+}
+
+// listAll collects the items of every page.
+func listAll[T any](ctx context.Context, client *NetlifyClient, req Request, opts ListOptions) ([]T, error) {
+	paginator := NewPaginator[T](client, req, opts)
+	var all []T
+	for paginator.HasNext() {
+		items, err := paginator.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
+
+var linkNextRegexp = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+
+// nextPage returns the page number of the rel="next" link, if any.
+func nextPage(header http.Header) (int, bool) {
+	for _, link := range header.Values("Link") {
+		match := linkNextRegexp.FindStringSubmatch(link)
+		if match == nil {
+			continue
+		}
+		nextURL, err := url.Parse(match[1])
+		if err != nil {
+			return 0, false
+		}
+		page, err := strconv.Atoi(nextURL.Query().Get("page"))
+		if err != nil {
+			return 0, false
+		}
+		return page, true
+	}
+	return 0, false
+}
@@ -0,0 +1,128 @@
+package netlify
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 4
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// rateLimiter keeps track of the rate limit headers sent back by Netlify so
+// that the client can wait for the window to reset instead of being rejected.
+type rateLimiter struct {
+	mu        sync.Mutex
+	remaining int
+	reset     time.Time
+}
+
+func (l *rateLimiter) update(res *http.Response) {
+	remaining, err := strconv.Atoi(res.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.remaining = remaining
+	l.reset = time.Unix(reset, 0)
+}
+
+// wait returns how long to hold off before sending the next request.
+func (l *rateLimiter) wait() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.remaining > 0 || l.reset.IsZero() {
+		return 0
+	}
+	wait := time.Until(l.reset)
+	if wait < 0 {
+		l.reset = time.Time{}
+		return 0
+	}
+	return wait
+}
+
+// shouldRetry reports whether a request sent with method that got res or
+// err may be sent again. Requests that are not idempotent, such as creating
+// a site or a deploy, are only sent again when Netlify surely did not act on
+// them: after a rate limit or when the connection could not be opened.
+func shouldRetry(method string, res *http.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+		if !isIdempotent(method) {
+			return false
+		}
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return true
+		}
+		return errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, syscall.ECONNREFUSED) ||
+			errors.Is(err, io.ErrUnexpectedEOF) ||
+			errors.Is(err, io.EOF)
+	}
+	if res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return isIdempotent(method) && res.StatusCode >= http.StatusInternalServerError
+}
+
+// isIdempotent reports whether sending a request with method twice has the
+// same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns the time to wait before the given retry attempt, starting
+// at 0. A Retry-After header on res takes precedence over the exponential
+// backoff.
+func (c *NetlifyClient) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if retryAfter := parseRetryAfter(res.Header.Get("Retry-After")); retryAfter > 0 {
+			return retryAfter
+		}
+	}
+
+	wait := float64(c.RetryWaitMin) * math.Pow(2, float64(attempt))
+	if wait > float64(c.RetryWaitMax) {
+		wait = float64(c.RetryWaitMax)
+	}
+	// Jitter keeps concurrent resources from retrying in lockstep.
+	return time.Duration(rand.Int63n(int64(wait)/2+1)) + time.Duration(wait/2)
+}
+
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
@@ -2,37 +2,111 @@ package netlify
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 )
 
 type Site struct {
-	Id           string `json:"id"`
-	CustomDomain string `json:"custom_domain"`
-	Name         string `json:"name"`
-	Url          string `json:"url"`
-	CreatedAt    string `json:"created_at"`
-	UpdatedAt    string `json:"updated_at"`
-	State        string `json:"state"`
+	Id            string        `json:"id"`
+	CustomDomain  string        `json:"custom_domain"`
+	DomainAliases []string      `json:"domain_aliases"`
+	ForceSSL      bool          `json:"force_ssl"`
+	SSL           bool          `json:"ssl"`
+	SslUrl        string        `json:"ssl_url"`
+	Name          string        `json:"name"`
+	Url           string        `json:"url"`
+	CreatedAt     string        `json:"created_at"`
+	UpdatedAt     string        `json:"updated_at"`
+	State         string        `json:"state"`
+	BuildSettings BuildSettings `json:"build_settings"`
+	// BuildImage is the image builds run in, e.g. focal.
+	BuildImage         string             `json:"build_image"`
+	ProcessingSettings ProcessingSettings `json:"processing_settings"`
+	// PublishedDeploy is the deploy served in production, if any.
+	PublishedDeploy *Deploy `json:"published_deploy"`
+}
+
+type BuildSettings struct {
+	Provider        string            `json:"provider"`
+	RepoPath        string            `json:"repo_path"`
+	RepoBranch      string            `json:"repo_branch"`
+	RepoUrl         string            `json:"repo_url"`
+	DeployKeyId     string            `json:"deploy_key_id"`
+	Cmd             string            `json:"cmd"`
+	Dir             string            `json:"dir"`
+	FunctionsDir    string            `json:"functions_dir"`
+	Base            string            `json:"base"`
+	AllowedBranches []string          `json:"allowed_branches"`
+	PublicRepo      bool              `json:"public_repo"`
+	PrivateLogs     bool              `json:"private_logs"`
+	StopBuilds      bool              `json:"stop_builds"`
+	InstallationId  int64             `json:"installation_id"`
+	Env             map[string]string `json:"env"`
+	SkipPrs         bool              `json:"skip_prs"`
+}
+
+// ProcessingSettings holds the post processing options of a site.
+type ProcessingSettings struct {
+	Skip            bool `json:"skip"`
+	IgnoreHtmlForms bool `json:"ignore_html_forms"`
+	Css             struct {
+		Bundle bool `json:"bundle"`
+		Minify bool `json:"minify"`
+	} `json:"css"`
+	Js struct {
+		Bundle bool `json:"bundle"`
+		Minify bool `json:"minify"`
+	} `json:"js"`
+	Images struct {
+		Optimize bool `json:"optimize"`
+	} `json:"images"`
+	Html struct {
+		PrettyUrls bool `json:"pretty_urls"`
+	} `json:"html"`
+}
+
+// ListSitesParams filters the sites returned by ListSites.
+type ListSitesParams struct {
+	// Name only keeps sites whose name contains the given string.
+	Name string
+	// Filter is one of all, owner or guest.
+	Filter string
 }
 
+// Repository holds the build settings sent when creating or updating a site.
+// Optional settings left empty are not sent, so that Netlify keeps its
+// current value.
 type Repository struct {
-	Provider    string `json:"provider"`
-	Path        string `json:"repo"`
-	Branch      string `json:"branch"`
-	DeployKeyId string `json:"deploy_key_id"`
-	Cmd         string `json:"cmd"`
-	Dir         string `json:"dir"`
-	Url         string `json:"repo_url"`
+	Provider        string            `json:"provider"`
+	Path            string            `json:"repo"`
+	Branch          string            `json:"branch"`
+	DeployKeyId     string            `json:"deploy_key_id"`
+	Cmd             string            `json:"cmd"`
+	Dir             string            `json:"dir"`
+	Url             string            `json:"repo_url"`
+	FunctionsDir    string            `json:"functions_dir,omitempty"`
+	Base            string            `json:"base,omitempty"`
+	AllowedBranches []string          `json:"allowed_branches,omitempty"`
+	PublicRepo      *bool             `json:"public_repo,omitempty"`
+	PrivateLogs     *bool             `json:"private_logs,omitempty"`
+	StopBuilds      *bool             `json:"stop_builds,omitempty"`
+	InstallationId  int64             `json:"installation_id,omitempty"`
+	Env             map[string]string `json:"env,omitempty"`
+	SkipPrs         *bool             `json:"skip_prs,omitempty"`
 }
 
 type SiteRequest struct {
-	Name         string     `json:"name"`
-	CustomDomain string     `json:"custom_domain"`
-	Repo         Repository `json:"repo"`
+	Name         string `json:"name"`
+	CustomDomain string `json:"custom_domain"`
+	// DomainAliases replaces the aliases of the site when set. Point it to
+	// an empty slice to remove them all.
+	DomainAliases *[]string   `json:"domain_aliases,omitempty"`
+	ForceSSL      *bool       `json:"force_ssl,omitempty"`
+	Repo          *Repository `json:"repo,omitempty"`
 }
 
-func (c *NetlifyClient) CreateSite(req SiteRequest) (*Site, error) {
+func (c *NetlifyClient) CreateSite(ctx context.Context, req SiteRequest) (*Site, error) {
 	jsonValue, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
@@ -45,7 +119,7 @@ func (c *NetlifyClient) CreateSite(req SiteRequest) (*Site, error) {
 	}
 
 	var resSite Site
-	err = c.Do(reqDo, &resSite)
+	err = c.Do(ctx, reqDo, &resSite)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +127,7 @@ func (c *NetlifyClient) CreateSite(req SiteRequest) (*Site, error) {
 	return &resSite, nil
 }
 
-func (c *NetlifyClient) GetSite(siteId string) (*Site, error) {
+func (c *NetlifyClient) GetSite(ctx context.Context, siteId string) (*Site, error) {
 	var site Site
 
 	reqDo := Request{
@@ -62,7 +136,7 @@ func (c *NetlifyClient) GetSite(siteId string) (*Site, error) {
 		Body:   &bytes.Buffer{},
 	}
 
-	err := c.Do(reqDo, &site)
+	err := c.Do(ctx, reqDo, &site)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +144,7 @@ func (c *NetlifyClient) GetSite(siteId string) (*Site, error) {
 	return &site, nil
 }
 
-func (c *NetlifyClient) UpdateSite(siteId string, req SiteRequest) (*Site, error) {
+func (c *NetlifyClient) UpdateSite(ctx context.Context, siteId string, req SiteRequest) (*Site, error) {
 	jsonValue, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
@@ -83,7 +157,7 @@ func (c *NetlifyClient) UpdateSite(siteId string, req SiteRequest) (*Site, error
 	}
 
 	var resSite Site
-	err = c.Do(reqDo, &resSite)
+	err = c.Do(ctx, reqDo, &resSite)
 	if err != nil {
 		return nil, err
 	}
@@ -91,11 +165,45 @@ func (c *NetlifyClient) UpdateSite(siteId string, req SiteRequest) (*Site, error
 	return &resSite, nil
 }
 
-func (c *NetlifyClient) DeleteSite(siteId string) error {
+// UnlinkSiteRepository disconnects the Git repository of a site, turning it
+// into a manual deploy site.
+func (c *NetlifyClient) UnlinkSiteRepository(ctx context.Context, siteId string) (*Site, error) {
+	reqDo := Request{
+		Method: http.MethodPatch,
+		Path:   "sites/" + siteId,
+		Body:   bytes.NewBufferString(`{"repo":null}`),
+	}
+
+	var resSite Site
+	err := c.Do(ctx, reqDo, &resSite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resSite, nil
+}
+
+func (c *NetlifyClient) DeleteSite(ctx context.Context, siteId string) error {
 	reqDo := Request{
 		Method: http.MethodDelete,
 		Path:   "sites/" + siteId,
 		Body:   &bytes.Buffer{},
 	}
-	return c.Do(reqDo, nil)
+	return c.Do(ctx, reqDo, nil)
+}
+
+func (c *NetlifyClient) ListSites(ctx context.Context, params ListSitesParams, opts ListOptions) ([]Site, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "sites",
+		Body:   &bytes.Buffer{},
+		Query:  map[string]string{},
+	}
+	if params.Name != "" {
+		reqDo.Query["name"] = params.Name
+	}
+	if params.Filter != "" {
+		reqDo.Query["filter"] = params.Filter
+	}
+	return listAll[Site](ctx, c, reqDo, opts)
 }
@@ -0,0 +1,112 @@
+package netlify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SiteSSL is the TLS certificate serving the domains of a site.
+type SiteSSL struct {
+	State     string   `json:"state"`
+	Domains   []string `json:"domains"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+	ExpiresAt string   `json:"expires_at"`
+}
+
+// SiteSSLCertificate is a certificate issued outside of Netlify, PEM encoded.
+type SiteSSLCertificate struct {
+	Certificate    string `json:"certificate"`
+	Key            string `json:"key"`
+	CACertificates string `json:"ca_certificates,omitempty"`
+}
+
+// UploadSiteSSLCertificate replaces the certificate of a site with a custom
+// one.
+func (c *NetlifyClient) UploadSiteSSLCertificate(ctx context.Context, siteId string, cert SiteSSLCertificate) (*SiteSSL, error) {
+	jsonValue, err := json.Marshal(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mask the key both as is and as escaped in the JSON body.
+	encodedKey, err := json.Marshal(cert.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	reqDo := Request{
+		Method:  http.MethodPost,
+		Path:    "sites/" + siteId + "/ssl",
+		Body:    bytes.NewBuffer(jsonValue),
+		Secrets: []string{cert.Key, string(encodedKey[1 : len(encodedKey)-1])},
+	}
+
+	var ssl SiteSSL
+	err = c.Do(ctx, reqDo, &ssl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssl, nil
+}
+
+// ProvisionSiteSSL asks Netlify to issue a Let's Encrypt certificate for the
+// custom domain and domain aliases of a site.
+func (c *NetlifyClient) ProvisionSiteSSL(ctx context.Context, siteId string) (*SiteSSL, error) {
+	reqDo := Request{
+		Method: http.MethodPost,
+		Path:   "sites/" + siteId + "/ssl",
+		Body:   &bytes.Buffer{},
+	}
+
+	var ssl SiteSSL
+	err := c.Do(ctx, reqDo, &ssl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssl, nil
+}
+
+func (c *NetlifyClient) GetSiteSSL(ctx context.Context, siteId string) (*SiteSSL, error) {
+	reqDo := Request{
+		Method: http.MethodGet,
+		Path:   "sites/" + siteId + "/ssl",
+		Body:   &bytes.Buffer{},
+	}
+
+	var ssl SiteSSL
+	err := c.Do(ctx, reqDo, &ssl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssl, nil
+}
+
+// WaitForSiteSSL polls the certificate of a site until it is issued.
+func (c *NetlifyClient) WaitForSiteSSL(ctx context.Context, siteId string) (*SiteSSL, error) {
+	var ssl *SiteSSL
+	err := c.poll(ctx, func() (bool, error) {
+		var err error
+		ssl, err = c.GetSiteSSL(ctx, siteId)
+		if err != nil {
+			return false, err
+		}
+		switch ssl.State {
+		case "issued":
+			return true, nil
+		case "failed", "error":
+			return false, fmt.Errorf("certificate provisioning for site %s %s", siteId, ssl.State)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ssl, nil
+}
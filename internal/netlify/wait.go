@@ -0,0 +1,22 @@
+package netlify
+
+import (
+	"context"
+	"time"
+)
+
+const defaultPollInterval = 5 * time.Second
+
+// poll calls check every PollInterval until it reports done, returns an error
+// or ctx expires.
+func (c *NetlifyClient) poll(ctx context.Context, check func() (bool, error)) error {
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		if err := sleep(ctx, c.PollInterval); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package netlifytest
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// handleSiteBuilds starts a build of a site, along with the deploy it
+// produces. Builds finish once they have been polled, or right away when the
+// builds of the site are stopped.
+func (s *Server) handleSiteBuilds(rt route, siteId string) {
+	site, ok := s.get("sites", siteId)
+	if !ok {
+		notFound(rt.w)
+		return
+	}
+	if rt.r.Method != http.MethodPost {
+		methodNotAllowed(rt.w)
+		return
+	}
+
+	var req map[string]any
+	if rt.r.ContentLength != 0 && !rt.decode(&req) {
+		return
+	}
+
+	buildId := s.newId()
+	sum := sha1.Sum([]byte(buildId))
+	sha := hex.EncodeToString(sum[:])
+
+	// Sites with stopped builds skip the build, which produces no deploy.
+	if settings, ok := site["build_settings"].(map[string]any); ok && settings["stop_builds"] == true {
+		build := map[string]any{
+			"id":         buildId,
+			"deploy_id":  nil,
+			"sha":        sha,
+			"done":       true,
+			"error":      nil,
+			"created_at": now(),
+		}
+		s.put("builds", buildId, build)
+		s.put("build_logs", buildId, map[string]any{"lines": []any{logLine("Builds are stopped, skipping build")}})
+		writeJSON(rt.w, http.StatusOK, build)
+		return
+	}
+
+	deploy := s.newDeploy(siteId, site)
+	deploy["build_id"] = buildId
+	deploy["commit_ref"] = sha
+	deployId, _ := deploy["id"].(string)
+
+	build := map[string]any{
+		"id":         buildId,
+		"deploy_id":  deployId,
+		"sha":        sha,
+		"done":       false,
+		"error":      nil,
+		"created_at": now(),
+	}
+	s.put("builds", buildId, build)
+
+	log := []any{logLine("Build ready to start")}
+	if clearCache, _ := req["clear_cache"].(bool); clearCache {
+		log = append(log, logLine("Clearing cache"))
+	}
+	s.put("build_logs", buildId, map[string]any{"lines": log})
+
+	writeJSON(rt.w, http.StatusOK, build)
+}
+
+func (s *Server) handleBuilds(rt route) {
+	if len(rt.segments) < 2 || rt.r.Method != http.MethodGet {
+		notFound(rt.w)
+		return
+	}
+	id := rt.segments[1]
+	build, ok := s.get("builds", id)
+	if !ok {
+		notFound(rt.w)
+		return
+	}
+
+	switch {
+	case len(rt.segments) == 2:
+		writeJSON(rt.w, http.StatusOK, build)
+		if build["done"] == false {
+			s.finishBuild(build)
+		}
+	case len(rt.segments) == 3 && rt.segments[2] == "log":
+		log, _ := s.get("build_logs", id)
+		writeJSON(rt.w, http.StatusOK, log["lines"])
+	default:
+		notFound(rt.w)
+	}
+}
+
+// finishBuild completes a build and its deploy, failing them when the build
+// command of the site contains "exit 1".
+func (s *Server) finishBuild(build map[string]any) {
+	buildId, _ := build["id"].(string)
+	deployId, _ := build["deploy_id"].(string)
+	deploy, _ := s.get("deploys", deployId)
+	site, _ := s.get("sites", deploy["site_id"].(string))
+
+	cmd := ""
+	if settings, ok := site["build_settings"].(map[string]any); ok {
+		cmd, _ = settings["cmd"].(string)
+	}
+
+	log, _ := s.get("build_logs", buildId)
+	lines, _ := log["lines"].([]any)
+	lines = append(lines, logLine("$ "+cmd))
+
+	build["done"] = true
+	deploy["updated_at"] = now()
+	if strings.Contains(cmd, "exit 1") {
+		lines = append(lines,
+			logLine(`Command failed with exit code 1: `+cmd),
+			logLine("Failed during stage 'building site': Build script returned non-zero exit code: 2"),
+		)
+		build["error"] = "Build script returned non-zero exit code: 2"
+		deploy["state"] = "error"
+		deploy["error_message"] = build["error"]
+	} else {
+		lines = append(lines, logLine("Build script success"), logLine("Site is live"))
+		deploy["state"] = "ready"
+		s.autoPublish(deploy)
+	}
+	log["lines"] = lines
+}
+
+func logLine(message string) map[string]any {
+	return map[string]any{"message": message, "ts": now()}
+}
@@ -0,0 +1,87 @@
+package netlifytest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+func (s *Server) handleBuildHooks(rt route, siteId string) {
+	site, ok := s.get("sites", siteId)
+	if !ok {
+		notFound(rt.w)
+		return
+	}
+
+	switch {
+	case len(rt.segments) == 3 && rt.r.Method == http.MethodGet:
+		hooks := s.list("build_hooks", func(_ string, hook map[string]any) bool {
+			return hook["site_id"] == siteId
+		})
+		writeJSON(rt.w, http.StatusOK, append([]map[string]any{}, hooks...))
+	case len(rt.segments) == 3 && rt.r.Method == http.MethodPost:
+		var req map[string]any
+		if !rt.decode(&req) {
+			return
+		}
+		if title, _ := req["title"].(string); title == "" {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Title can't be blank")
+			return
+		}
+		token := make([]byte, 12)
+		_, _ = rand.Read(token)
+		id := s.newId()
+		hook := map[string]any{
+			"id":         id,
+			"title":      req["title"],
+			"branch":     req["branch"],
+			"url":        "https://api.netlify.com/build_hooks/" + hex.EncodeToString(token),
+			"site_id":    siteId,
+			"created_at": now(),
+		}
+		if hook["branch"] == nil || hook["branch"] == "" {
+			hook["branch"] = productionBranch(site)
+		}
+		s.put("build_hooks", id, hook)
+		writeJSON(rt.w, http.StatusCreated, hook)
+	case len(rt.segments) == 4:
+		id := rt.segments[3]
+		hook, ok := s.get("build_hooks", id)
+		if !ok || hook["site_id"] != siteId {
+			notFound(rt.w)
+			return
+		}
+		switch rt.r.Method {
+		case http.MethodGet:
+			writeJSON(rt.w, http.StatusOK, hook)
+		case http.MethodPut:
+			var req map[string]any
+			if !rt.decode(&req) {
+				return
+			}
+			hook["title"] = req["title"]
+			if branch, _ := req["branch"].(string); branch != "" {
+				hook["branch"] = branch
+			}
+			rt.w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			delete(s.collections["build_hooks"], id)
+			rt.w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(rt.w)
+		}
+	default:
+		notFound(rt.w)
+	}
+}
+
+// productionBranch returns the branch the site is deployed from, main for
+// sites without a repository.
+func productionBranch(site map[string]any) string {
+	if settings, ok := site["build_settings"].(map[string]any); ok {
+		if branch, _ := settings["repo_branch"].(string); branch != "" {
+			return branch
+		}
+	}
+	return "main"
+}
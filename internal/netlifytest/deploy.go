@@ -0,0 +1,297 @@
+package netlifytest
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// newDeploy stores a new production deploy of a site, in the building
+// state.
+func (s *Server) newDeploy(siteId string, site map[string]any) map[string]any {
+	name, _ := site["name"].(string)
+	id := s.newId()
+	deploy := map[string]any{
+		"id":                 id,
+		"site_id":            siteId,
+		"build_id":           nil,
+		"state":              "building",
+		"name":               name,
+		"url":                site["url"],
+		"ssl_url":            site["ssl_url"],
+		"admin_url":          site["admin_url"],
+		"deploy_url":         "http://" + id + "--" + name + ".netlify.app",
+		"deploy_ssl_url":     "https://" + id + "--" + name + ".netlify.app",
+		"branch":             productionBranch(site),
+		"commit_ref":         nil,
+		"context":            "production",
+		"title":              nil,
+		"error_message":      "",
+		"locked":             false,
+		"created_at":         now(),
+		"updated_at":         now(),
+		"published_at":       nil,
+		"required":           []any{},
+		"required_functions": []any{},
+	}
+	s.put("deploys", id, deploy)
+	return deploy
+}
+
+// createSiteDeploy creates a deploy from file digests. Files whose content
+// was uploaded before, by any deploy, are not required again. Deploys are
+// processed once every required file has been uploaded.
+func (s *Server) createSiteDeploy(rt route, siteId string) {
+	site, ok := s.get("sites", siteId)
+	if !ok {
+		notFound(rt.w)
+		return
+	}
+
+	if rt.r.Header.Get("Content-Type") == "application/zip" {
+		s.createZipDeploy(rt, siteId, site)
+		return
+	}
+
+	var req struct {
+		Files     map[string]string `json:"files"`
+		Functions map[string]string `json:"functions"`
+		Draft     bool              `json:"draft"`
+		Title     string            `json:"title"`
+	}
+	if !rt.decode(&req) {
+		return
+	}
+
+	deploy := s.newDeploy(siteId, site)
+	deploy["state"] = "uploading"
+	if req.Title != "" {
+		deploy["title"] = req.Title
+	}
+	if req.Draft {
+		deploy["context"] = "draft"
+	}
+
+	files := map[string]any{}
+	required := []any{}
+	for path, sum := range req.Files {
+		files[path] = sum
+		if _, ok := s.get("blobs", sum); !ok && !contains(required, sum) {
+			required = append(required, sum)
+		}
+	}
+	functions := map[string]any{}
+	requiredFunctions := []any{}
+	for name, sum := range req.Functions {
+		functions[name] = sum
+		if _, ok := s.get("blobs", sum); !ok && !contains(requiredFunctions, sum) {
+			requiredFunctions = append(requiredFunctions, sum)
+		}
+	}
+	deploy["files"] = files
+	deploy["functions"] = functions
+	deploy["required"] = required
+	deploy["required_functions"] = requiredFunctions
+	deploy["draft"] = req.Draft
+	updateUploadState(deploy)
+
+	writeJSON(rt.w, http.StatusOK, deploy)
+}
+
+// createZipDeploy creates a deploy from a zip archive of its files, which
+// is processed right away.
+func (s *Server) createZipDeploy(rt route, siteId string, site map[string]any) {
+	body, err := io.ReadAll(rt.r.Body)
+	if err != nil {
+		writeError(rt.w, http.StatusBadRequest, err.Error())
+		return
+	}
+	archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		writeError(rt.w, http.StatusUnprocessableEntity, "Invalid zip archive: "+err.Error())
+		return
+	}
+
+	files := map[string]any{}
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		f, err := file.Open()
+		if err != nil {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Invalid zip archive: "+err.Error())
+			return
+		}
+		h := sha1.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Invalid zip archive: "+err.Error())
+			return
+		}
+		sum := hex.EncodeToString(h.Sum(nil))
+		files["/"+file.Name] = sum
+		s.put("blobs", sum, map[string]any{})
+	}
+
+	query := rt.r.URL.Query()
+	deploy := s.newDeploy(siteId, site)
+	deploy["state"] = "processing"
+	deploy["files"] = files
+	deploy["functions"] = map[string]any{}
+	deploy["draft"] = query.Get("draft") == "true"
+	if title := query.Get("title"); title != "" {
+		deploy["title"] = title
+	}
+	if deploy["draft"] == true {
+		deploy["context"] = "draft"
+	}
+
+	writeJSON(rt.w, http.StatusOK, deploy)
+}
+
+func (s *Server) handleDeploys(rt route) {
+	if len(rt.segments) < 2 {
+		notFound(rt.w)
+		return
+	}
+	deploy, ok := s.get("deploys", rt.segments[1])
+	if !ok {
+		notFound(rt.w)
+		return
+	}
+
+	switch {
+	case len(rt.segments) == 2 && rt.r.Method == http.MethodGet:
+		writeJSON(rt.w, http.StatusOK, deploy)
+		if deploy["state"] == "processing" {
+			deploy["state"] = "ready"
+			deploy["updated_at"] = now()
+			s.autoPublish(deploy)
+		}
+	case len(rt.segments) == 3 && (rt.segments[2] == "lock" || rt.segments[2] == "unlock") && rt.r.Method == http.MethodPost:
+		site, _ := s.get("sites", deploy["site_id"].(string))
+		if published, _ := site["published_deploy"].(map[string]any); published["id"] != deploy["id"] {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Only the published deploy can be locked")
+			return
+		}
+		deploy["locked"] = rt.segments[2] == "lock"
+		deploy["updated_at"] = now()
+		writeJSON(rt.w, http.StatusOK, deploy)
+	case len(rt.segments) > 3 && rt.segments[2] == "files" && rt.r.Method == http.MethodPut:
+		path := "/" + strings.Join(rt.segments[3:], "/")
+		s.uploadDeployContent(rt, deploy, "files", "required", path, sha1.New())
+	case len(rt.segments) == 4 && rt.segments[2] == "functions" && rt.r.Method == http.MethodPut:
+		s.uploadDeployContent(rt, deploy, "functions", "required_functions", rt.segments[3], sha256.New())
+	default:
+		notFound(rt.w)
+	}
+}
+
+// uploadDeployContent stores an uploaded file or function after checking it
+// matches the digest it was announced with.
+func (s *Server) uploadDeployContent(rt route, deploy map[string]any, kind string, requiredKey string, name string, h hash.Hash) {
+	if rt.r.Header.Get("Content-Type") != "application/octet-stream" {
+		writeError(rt.w, http.StatusUnsupportedMediaType, "Uploads must be sent as application/octet-stream")
+		return
+	}
+	if deploy["state"] != "uploading" {
+		writeError(rt.w, http.StatusUnprocessableEntity, "Deploy is not accepting uploads")
+		return
+	}
+	expected, ok := deploy[kind].(map[string]any)[name].(string)
+	if !ok {
+		writeError(rt.w, http.StatusUnprocessableEntity, "Unknown "+kind+" "+name)
+		return
+	}
+	if _, err := io.Copy(h, rt.r.Body); err != nil {
+		writeError(rt.w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != expected {
+		writeError(rt.w, http.StatusUnprocessableEntity, "Digest mismatch for "+name)
+		return
+	}
+
+	s.put("blobs", expected, map[string]any{})
+	var required []any
+	for _, sum := range deploy[requiredKey].([]any) {
+		if sum != expected {
+			required = append(required, sum)
+		}
+	}
+	deploy[requiredKey] = append([]any{}, required...)
+	updateUploadState(deploy)
+
+	writeJSON(rt.w, http.StatusOK, map[string]any{"id": name, "sha": expected})
+}
+
+// restoreSiteDeploy publishes a previous deploy. When the site is locked, the
+// lock moves to the restored deploy.
+func (s *Server) restoreSiteDeploy(rt route, siteId string, deployId string) {
+	if rt.r.Method != http.MethodPost {
+		methodNotAllowed(rt.w)
+		return
+	}
+	deploy, ok := s.get("deploys", deployId)
+	if !ok || deploy["site_id"] != siteId {
+		notFound(rt.w)
+		return
+	}
+	if deploy["state"] != "ready" {
+		writeError(rt.w, http.StatusUnprocessableEntity, "Only ready deploys can be published")
+		return
+	}
+
+	site, _ := s.get("sites", siteId)
+	if published, _ := site["published_deploy"].(map[string]any); published["locked"] == true {
+		published["locked"] = false
+		deploy["locked"] = true
+	}
+	s.publishDeploy(deploy)
+	writeJSON(rt.w, http.StatusOK, deploy)
+}
+
+// autoPublish publishes a deploy that just became ready, unless it is a
+// draft or the published deploy of the site is locked.
+func (s *Server) autoPublish(deploy map[string]any) {
+	if deploy["draft"] == true {
+		return
+	}
+	site, _ := s.get("sites", deploy["site_id"].(string))
+	if published, _ := site["published_deploy"].(map[string]any); published["locked"] == true {
+		return
+	}
+	s.publishDeploy(deploy)
+}
+
+// publishDeploy makes deploy the one served by its site.
+func (s *Server) publishDeploy(deploy map[string]any) {
+	deploy["published_at"] = now()
+	deploy["updated_at"] = now()
+	if site, ok := s.get("sites", deploy["site_id"].(string)); ok {
+		site["published_deploy"] = deploy
+	}
+}
+
+// updateUploadState moves a deploy to processing once nothing is required.
+func updateUploadState(deploy map[string]any) {
+	if len(deploy["required"].([]any)) == 0 && len(deploy["required_functions"].([]any)) == 0 {
+		deploy["state"] = "processing"
+	}
+}
+
+func contains(values []any, value any) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
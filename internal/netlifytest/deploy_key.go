@@ -0,0 +1,61 @@
+package netlifytest
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"net/http"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func (s *Server) handleDeployKeys(rt route) {
+	switch {
+	case len(rt.segments) == 1 && rt.r.Method == http.MethodPost:
+		publicKey, err := newPublicKey()
+		if err != nil {
+			writeError(rt.w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		id := s.newId()
+		key := map[string]any{
+			"id":         id,
+			"public_key": publicKey,
+			"created_at": now(),
+		}
+		s.put("deploy_keys", id, key)
+		writeJSON(rt.w, http.StatusCreated, key)
+	case len(rt.segments) == 2:
+		id := rt.segments[1]
+		key, ok := s.get("deploy_keys", id)
+		if !ok {
+			notFound(rt.w)
+			return
+		}
+		switch rt.r.Method {
+		case http.MethodGet:
+			writeJSON(rt.w, http.StatusOK, key)
+		case http.MethodDelete:
+			delete(s.collections["deploy_keys"], id)
+			rt.w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(rt.w)
+		}
+	default:
+		notFound(rt.w)
+	}
+}
+
+// newPublicKey generates an OpenSSH public key, ed25519 being much faster
+// to generate than the RSA keys Netlify hands out.
+func newPublicKey() (string, error) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub))), nil
+}
@@ -0,0 +1,149 @@
+package netlifytest
+
+import (
+	"net/http"
+	"strings"
+)
+
+// dnsServers are the name servers returned for every zone.
+var dnsServers = []any{"dns1.p01.nsone.net", "dns2.p01.nsone.net", "dns3.p01.nsone.net", "dns4.p01.nsone.net"}
+
+func (s *Server) handleDNSZones(rt route) {
+	switch {
+	case len(rt.segments) == 1 && rt.r.Method == http.MethodGet:
+		accountSlug := rt.r.URL.Query().Get("account_slug")
+		rt.paginate(s.list("dns_zones", func(_ string, zone map[string]any) bool {
+			return accountSlug == "" || zone["account_slug"] == accountSlug
+		}))
+	case len(rt.segments) == 1 && rt.r.Method == http.MethodPost:
+		var req map[string]any
+		if !rt.decode(&req) {
+			return
+		}
+		name, _ := req["name"].(string)
+		if name == "" || !strings.Contains(name, ".") {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Invalid zone name")
+			return
+		}
+		if len(s.list("dns_zones", func(_ string, zone map[string]any) bool { return zone["name"] == name })) > 0 {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Zone already exists")
+			return
+		}
+		// Only an absent account slug defaults to the account of the token.
+		accountSlug, ok := req["account_slug"].(string)
+		if ok && accountSlug == "" {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Account not found")
+			return
+		}
+		if !ok {
+			accountSlug = AccountSlug
+		}
+		id := s.newId()
+		zone := map[string]any{
+			"id":                     id,
+			"name":                   name,
+			"account_slug":           accountSlug,
+			"site_id":                req["site_id"],
+			"dns_servers":            dnsServers,
+			"supported_record_types": []any{"A", "AAAA", "ALIAS", "CAA", "CNAME", "MX", "NS", "SPF", "SRV", "TXT"},
+			"created_at":             now(),
+			"updated_at":             now(),
+		}
+		s.put("dns_zones", id, zone)
+		writeJSON(rt.w, http.StatusCreated, zone)
+	case len(rt.segments) == 2:
+		id := rt.segments[1]
+		zone, ok := s.get("dns_zones", id)
+		if !ok {
+			notFound(rt.w)
+			return
+		}
+		switch rt.r.Method {
+		case http.MethodGet:
+			writeJSON(rt.w, http.StatusOK, zone)
+		case http.MethodDelete:
+			delete(s.collections["dns_zones"], id)
+			for recordId, record := range s.collections["dns_records"] {
+				if record["dns_zone_id"] == id {
+					delete(s.collections["dns_records"], recordId)
+				}
+			}
+			rt.w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(rt.w)
+		}
+	case len(rt.segments) >= 3 && rt.segments[2] == "dns_records":
+		s.handleDNSRecords(rt, rt.segments[1])
+	default:
+		notFound(rt.w)
+	}
+}
+
+// recordTypes are the record types accepted by the fake.
+var recordTypes = map[string]bool{
+	"A": true, "AAAA": true, "CNAME": true, "MX": true, "TXT": true, "NS": true,
+	"SRV": true, "CAA": true, "NETLIFY": true, "NETLIFYv6": true,
+}
+
+func (s *Server) handleDNSRecords(rt route, zoneId string) {
+	if _, ok := s.get("dns_zones", zoneId); !ok {
+		notFound(rt.w)
+		return
+	}
+
+	switch {
+	case len(rt.segments) == 3 && rt.r.Method == http.MethodGet:
+		rt.paginate(s.list("dns_records", func(_ string, record map[string]any) bool {
+			return record["dns_zone_id"] == zoneId
+		}))
+	case len(rt.segments) == 3 && rt.r.Method == http.MethodPost:
+		var req map[string]any
+		if !rt.decode(&req) {
+			return
+		}
+		recordType, _ := req["type"].(string)
+		if !recordTypes[recordType] {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Invalid record type")
+			return
+		}
+		if recordType == "MX" && req["priority"] == nil {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Priority is required for MX records")
+			return
+		}
+		id := s.newId()
+		record := map[string]any{
+			"id":          id,
+			"dns_zone_id": zoneId,
+			"ttl":         3600,
+			"priority":    nil,
+			"weight":      nil,
+			"port":        nil,
+			"flag":        nil,
+			"tag":         nil,
+			"managed":     false,
+		}
+		for key, value := range req {
+			record[key] = value
+		}
+		s.put("dns_records", id, record)
+		writeJSON(rt.w, http.StatusCreated, record)
+	case len(rt.segments) == 4:
+		id := rt.segments[3]
+		record, ok := s.get("dns_records", id)
+		if !ok || record["dns_zone_id"] != zoneId {
+			notFound(rt.w)
+			return
+		}
+		switch rt.r.Method {
+		case http.MethodGet:
+			writeJSON(rt.w, http.StatusOK, record)
+		case http.MethodDelete:
+			delete(s.collections["dns_records"], id)
+			rt.w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(rt.w)
+		}
+	default:
+		notFound(rt.w)
+	}
+}
@@ -0,0 +1,148 @@
+package netlifytest
+
+import (
+	"net/http"
+	"strings"
+)
+
+var defaultEnvVarScopes = []any{"builds", "functions", "runtime", "post-processing"}
+
+// handleAccounts serves the account scoped endpoints, i.e. env variables.
+func (s *Server) handleAccounts(rt route) {
+	if len(rt.segments) < 3 || rt.segments[2] != "env" {
+		notFound(rt.w)
+		return
+	}
+	siteId := rt.r.URL.Query().Get("site_id")
+
+	switch {
+	case len(rt.segments) == 3 && rt.r.Method == http.MethodGet:
+		var envVars []map[string]any
+		for _, envVar := range s.list("env", func(id string, _ map[string]any) bool {
+			return strings.HasPrefix(id, siteId+"/")
+		}) {
+			key, _ := envVar["key"].(string)
+			envVars = append(envVars, s.envVarResponse(siteId, key))
+		}
+		rt.paginate(envVars)
+	case len(rt.segments) == 3 && rt.r.Method == http.MethodPost:
+		var req []map[string]any
+		if !rt.decode(&req) {
+			return
+		}
+		var res []any
+		for _, envVar := range req {
+			key, _ := envVar["key"].(string)
+			if _, ok := s.get("env", siteId+"/"+key); ok {
+				writeError(rt.w, http.StatusConflict, "env var already exists")
+				return
+			}
+			s.putEnvVar(siteId, envVar)
+			res = append(res, s.envVarResponse(siteId, key))
+		}
+		writeJSON(rt.w, http.StatusCreated, res)
+	case len(rt.segments) == 4:
+		key := rt.segments[3]
+		envVar, ok := s.get("env", siteId+"/"+key)
+		if !ok {
+			notFound(rt.w)
+			return
+		}
+		switch rt.r.Method {
+		case http.MethodGet:
+			writeJSON(rt.w, http.StatusOK, s.envVarResponse(siteId, key))
+		case http.MethodPut:
+			var req map[string]any
+			if !rt.decode(&req) {
+				return
+			}
+			delete(s.collections["env"], siteId+"/"+key)
+			s.putEnvVar(siteId, req)
+			newKey, _ := req["key"].(string)
+			writeJSON(rt.w, http.StatusOK, s.envVarResponse(siteId, newKey))
+		case http.MethodPatch:
+			var req map[string]any
+			if !rt.decode(&req) {
+				return
+			}
+			if req["context_parameter"] == nil {
+				req["context_parameter"] = ""
+			}
+			values, _ := envVar["values"].([]any)
+			replaced := false
+			for i, value := range values {
+				value := value.(map[string]any)
+				if value["context"] == req["context"] && value["context_parameter"] == req["context_parameter"] {
+					req["id"] = value["id"]
+					values[i] = req
+					replaced = true
+				}
+			}
+			if !replaced {
+				req["id"] = s.newId()
+				values = append(values, req)
+			}
+			envVar["values"] = values
+			envVar["updated_at"] = now()
+			writeJSON(rt.w, http.StatusOK, s.envVarResponse(siteId, key))
+		case http.MethodDelete:
+			delete(s.collections["env"], siteId+"/"+key)
+			rt.w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(rt.w)
+		}
+	case len(rt.segments) == 6 && rt.segments[4] == "value" && rt.r.Method == http.MethodDelete:
+		envVar, ok := s.get("env", siteId+"/"+rt.segments[3])
+		if !ok {
+			notFound(rt.w)
+			return
+		}
+		values, _ := envVar["values"].([]any)
+		var kept []any
+		for _, value := range values {
+			if value.(map[string]any)["id"] != rt.segments[5] {
+				kept = append(kept, value)
+			}
+		}
+		if len(kept) == len(values) {
+			notFound(rt.w)
+			return
+		}
+		envVar["values"] = kept
+		rt.w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(rt.w)
+	}
+}
+
+func (s *Server) putEnvVar(siteId string, envVar map[string]any) {
+	if scopes, _ := envVar["scopes"].([]any); len(scopes) == 0 {
+		envVar["scopes"] = defaultEnvVarScopes
+	}
+	values, _ := envVar["values"].([]any)
+	for _, value := range values {
+		value := value.(map[string]any)
+		value["id"] = s.newId()
+		if value["context_parameter"] == nil {
+			value["context_parameter"] = ""
+		}
+	}
+	envVar["values"] = values
+	envVar["updated_at"] = now()
+	key, _ := envVar["key"].(string)
+	s.put("env", siteId+"/"+key, envVar)
+}
+
+// envVarResponse renders an env var the way the API does, hiding the values
+// of secret variables.
+func (s *Server) envVarResponse(siteId string, key string) map[string]any {
+	envVar, _ := s.get("env", siteId+"/"+key)
+	res := clone(envVar)
+	if secret, _ := res["is_secret"].(bool); secret {
+		values, _ := res["values"].([]any)
+		for _, value := range values {
+			value.(map[string]any)["value"] = ""
+		}
+	}
+	return res
+}
@@ -0,0 +1,118 @@
+package netlifytest
+
+import "net/http"
+
+var deployEvents = []any{"deploy_created", "deploy_building", "deploy_failed", "deploy_locked", "deploy_unlocked", "deploy_request_pending", "deploy_request_accepted", "deploy_request_rejected"}
+
+// hookTypes are the hook types served by /hooks/types.
+var hookTypes = []map[string]any{
+	{
+		"name":   "url",
+		"events": append(append([]any{}, deployEvents...), "submission_created"),
+		"fields": []any{
+			map[string]any{"name": "url", "required": true},
+			map[string]any{"name": "signature_secret", "required": false},
+		},
+	},
+	{
+		"name":   "email",
+		"events": append(append([]any{}, deployEvents...), "submission_created"),
+		"fields": []any{
+			map[string]any{"name": "email", "required": true},
+		},
+	},
+	{
+		"name":   "slack",
+		"events": deployEvents,
+		"fields": []any{
+			map[string]any{"name": "url", "required": true},
+		},
+	},
+}
+
+func (s *Server) handleHooks(rt route) {
+	switch {
+	case len(rt.segments) == 2 && rt.segments[1] == "types" && rt.r.Method == http.MethodGet:
+		writeJSON(rt.w, http.StatusOK, hookTypes)
+	case len(rt.segments) == 1 && rt.r.Method == http.MethodGet:
+		siteId := rt.r.URL.Query().Get("site_id")
+		hooks := s.list("hooks", func(_ string, hook map[string]any) bool {
+			return hook["site_id"] == siteId
+		})
+		writeJSON(rt.w, http.StatusOK, append([]map[string]any{}, hooks...))
+	case len(rt.segments) == 1 && rt.r.Method == http.MethodPost:
+		siteId := rt.r.URL.Query().Get("site_id")
+		if _, ok := s.get("sites", siteId); !ok {
+			notFound(rt.w)
+			return
+		}
+		var req map[string]any
+		if !rt.decode(&req) {
+			return
+		}
+		if !validHook(req) {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Invalid hook type or event")
+			return
+		}
+		id := s.newId()
+		hook := map[string]any{
+			"id":         id,
+			"site_id":    siteId,
+			"type":       req["type"],
+			"event":      req["event"],
+			"data":       req["data"],
+			"disabled":   false,
+			"created_at": now(),
+			"updated_at": now(),
+		}
+		s.put("hooks", id, hook)
+		writeJSON(rt.w, http.StatusCreated, hook)
+	case len(rt.segments) == 2:
+		id := rt.segments[1]
+		hook, ok := s.get("hooks", id)
+		if !ok {
+			notFound(rt.w)
+			return
+		}
+		switch rt.r.Method {
+		case http.MethodGet:
+			writeJSON(rt.w, http.StatusOK, hook)
+		case http.MethodPut:
+			var req map[string]any
+			if !rt.decode(&req) {
+				return
+			}
+			if !validHook(req) {
+				writeError(rt.w, http.StatusUnprocessableEntity, "Invalid hook type or event")
+				return
+			}
+			hook["type"] = req["type"]
+			hook["event"] = req["event"]
+			hook["data"] = req["data"]
+			hook["updated_at"] = now()
+			writeJSON(rt.w, http.StatusOK, hook)
+		case http.MethodDelete:
+			delete(s.collections["hooks"], id)
+			rt.w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(rt.w)
+		}
+	default:
+		notFound(rt.w)
+	}
+}
+
+// validHook checks the type and event of a hook request.
+func validHook(req map[string]any) bool {
+	for _, hookType := range hookTypes {
+		if hookType["name"] != req["type"] {
+			continue
+		}
+		for _, event := range hookType["events"].([]any) {
+			if event == req["event"] {
+				return true
+			}
+		}
+	}
+	return false
+}
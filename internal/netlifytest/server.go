@@ -0,0 +1,329 @@
+// Package netlifytest provides an in-memory fake of the Netlify API for
+// tests. The fake keeps its state in maps of decoded JSON objects and only
+// implements the endpoints used by the provider.
+package netlifytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Token is the personal token accepted by the fake server.
+const Token = "netlifytest-token"
+
+// AccountSlug is the slug of the account owning every fake resource.
+const AccountSlug = "netlifytest"
+
+const apiPrefix = "/api/v1/"
+
+// Server is an in-memory fake of the Netlify API.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	nextId      int
+	collections map[string]map[string]map[string]any
+	faults      []*Fault
+	requests    []string
+}
+
+// Fault makes the server misbehave for requests matching Method and
+// PathPrefix. Path prefixes are relative to the API root, e.g. "sites/".
+type Fault struct {
+	Method     string
+	PathPrefix string
+	// StatusCode is answered instead of handling the request. Zero lets the
+	// request through, after Delay.
+	StatusCode int
+	Delay      time.Duration
+	Header     http.Header
+	// Times is the number of requests affected. Zero means every request.
+	Times int
+}
+
+// NewServer starts a fake Netlify API. Callers must Close it.
+func NewServer() *Server {
+	s := &Server{
+		collections: map[string]map[string]map[string]any{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.Put("users", "me", map[string]any{
+		"id":           "user-1",
+		"uid":          "uid-1",
+		"slug":         AccountSlug,
+		"full_name":    "Netlify Test",
+		"avatar_url":   "",
+		"email":        "test@example.com",
+		"affiliate_id": "",
+		"site_count":   0,
+		"created_at":   now(),
+		"last_login":   now(),
+	})
+	return s
+}
+
+// BaseURL returns the URL to configure the client with.
+func (s *Server) BaseURL() string {
+	return s.URL + apiPrefix
+}
+
+// AddFault registers a fault. Faults are matched in registration order.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// Requests returns the "METHOD path" of every request received so far.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Get returns a copy of the object stored under id in collection, or nil.
+func (s *Server) Get(collection string, id string) map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return clone(s.collections[collection][id])
+}
+
+// Put stores obj under id in collection, replacing any previous object.
+func (s *Server) Put(collection string, id string, obj map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(collection, id, obj)
+}
+
+// Remove deletes an object, as if it was deleted out of band in the UI.
+func (s *Server) Remove(collection string, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.collections[collection], id)
+}
+
+func (s *Server) put(collection string, id string, obj map[string]any) {
+	if s.collections[collection] == nil {
+		s.collections[collection] = map[string]map[string]any{}
+	}
+	s.collections[collection][id] = obj
+}
+
+func (s *Server) get(collection string, id string) (map[string]any, bool) {
+	obj, ok := s.collections[collection][id]
+	return obj, ok
+}
+
+func (s *Server) newId() string {
+	s.nextId++
+	return strconv.Itoa(s.nextId)
+}
+
+// fault returns the first fault matching req, consuming one of its uses.
+func (s *Server) fault(method string, path string) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != method {
+			continue
+		}
+		if !strings.HasPrefix(path, f.PathPrefix) {
+			continue
+		}
+		matched := *f
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return &matched
+	}
+	return nil
+}
+
+// route holds a request once its path has been split into segments.
+type route struct {
+	w        http.ResponseWriter
+	r        *http.Request
+	segments []string
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, apiPrefix)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+path)
+	s.mu.Unlock()
+
+	w.Header().Set("X-Nf-Request-Id", fmt.Sprintf("req-%d", time.Now().UnixNano()))
+
+	if f := s.fault(r.Method, path); f != nil {
+		if f.Delay > 0 {
+			select {
+			case <-time.After(f.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		for key, values := range f.Header {
+			w.Header()[key] = values
+		}
+		if f.StatusCode != 0 {
+			writeError(w, f.StatusCode, http.StatusText(f.StatusCode))
+			return
+		}
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+Token {
+		writeError(w, http.StatusUnauthorized, "Access Denied")
+		return
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	rt := route{w: w, r: r, segments: segments}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch segments[0] {
+	case "user":
+		s.handleUser(rt)
+	case "deploy_keys":
+		s.handleDeployKeys(rt)
+	case "sites":
+		s.handleSites(rt)
+	case "accounts":
+		s.handleAccounts(rt)
+	case "dns_zones":
+		s.handleDNSZones(rt)
+	case "hooks":
+		s.handleHooks(rt)
+	case "builds":
+		s.handleBuilds(rt)
+	case "deploys":
+		s.handleDeploys(rt)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+// list returns the objects of collection accepted by match, in creation
+// order.
+func (s *Server) list(collection string, match func(id string, obj map[string]any) bool) []map[string]any {
+	var ids []string
+	for id, obj := range s.collections[collection] {
+		if match == nil || match(id, obj) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, errA := strconv.Atoi(ids[i])
+		b, errB := strconv.Atoi(ids[j])
+		if errA != nil || errB != nil {
+			return ids[i] < ids[j]
+		}
+		return a < b
+	})
+
+	var res []map[string]any
+	for _, id := range ids {
+		res = append(res, s.collections[collection][id])
+	}
+	return res
+}
+
+// paginate writes the requested page of items, along with the Link header
+// pointing to the next page.
+func (rt route) paginate(items []map[string]any) {
+	query := rt.r.URL.Query()
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = 100
+	}
+
+	start := (page - 1) * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	if end < len(items) {
+		next := *rt.r.URL
+		query.Set("page", strconv.Itoa(page+1))
+		next.RawQuery = query.Encode()
+		rt.w.Header().Set("Link", "<http://"+rt.r.Host+next.String()+`>; rel="next"`)
+	}
+
+	res := make([]map[string]any, 0, end-start)
+	res = append(res, items[start:end]...)
+	writeJSON(rt.w, http.StatusOK, res)
+}
+
+func (rt route) decode(dest any) bool {
+	if err := json.NewDecoder(rt.r.Body).Decode(dest); err != nil {
+		writeError(rt.w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"code":    status,
+		"message": message,
+	})
+}
+
+func notFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// clone deep copies a decoded JSON value.
+func clone(obj map[string]any) map[string]any {
+	if obj == nil {
+		return nil
+	}
+	raw, _ := json.Marshal(obj)
+	var res map[string]any
+	_ = json.Unmarshal(raw, &res)
+	return res
+}
@@ -0,0 +1,247 @@
+package netlifytest
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func (s *Server) handleSites(rt route) {
+	switch {
+	case len(rt.segments) == 1 && rt.r.Method == http.MethodGet:
+		name := rt.r.URL.Query().Get("name")
+		rt.paginate(s.list("sites", func(_ string, site map[string]any) bool {
+			siteName, _ := site["name"].(string)
+			return strings.Contains(siteName, name)
+		}))
+	case len(rt.segments) == 3 && rt.segments[2] == "deploys" && rt.r.Method == http.MethodGet:
+		siteId := rt.segments[1]
+		if _, ok := s.get("sites", siteId); !ok {
+			notFound(rt.w)
+			return
+		}
+		query := rt.r.URL.Query()
+		deploys := s.list("deploys", func(_ string, deploy map[string]any) bool {
+			if deploy["site_id"] != siteId {
+				return false
+			}
+			if state := query.Get("state"); state != "" && deploy["state"] != state {
+				return false
+			}
+			if branch := query.Get("branch"); branch != "" && deploy["branch"] != branch {
+				return false
+			}
+			if production := query.Get("production"); production != "" && (deploy["context"] == "production") != (production == "true") {
+				return false
+			}
+			return true
+		})
+		// Netlify lists the most recent deploys first.
+		for i, j := 0, len(deploys)-1; i < j; i, j = i+1, j-1 {
+			deploys[i], deploys[j] = deploys[j], deploys[i]
+		}
+		rt.paginate(deploys)
+	case len(rt.segments) == 5 && rt.segments[2] == "deploys" && rt.segments[4] == "restore":
+		s.restoreSiteDeploy(rt, rt.segments[1], rt.segments[3])
+	case len(rt.segments) == 3 && rt.segments[2] == "deploys" && rt.r.Method == http.MethodPost:
+		s.createSiteDeploy(rt, rt.segments[1])
+	case len(rt.segments) >= 3 && rt.segments[2] == "build_hooks":
+		s.handleBuildHooks(rt, rt.segments[1])
+	case len(rt.segments) == 3 && rt.segments[2] == "builds":
+		s.handleSiteBuilds(rt, rt.segments[1])
+	case len(rt.segments) == 3 && rt.segments[2] == "ssl":
+		s.handleSiteSSL(rt, rt.segments[1])
+	case len(rt.segments) == 1 && rt.r.Method == http.MethodPost:
+		var req map[string]any
+		if !rt.decode(&req) {
+			return
+		}
+		id := s.newId()
+		site := map[string]any{
+			"id":               id,
+			"name":             "site-" + id,
+			"created_at":       now(),
+			"state":            "current",
+			"domain_aliases":   []any{},
+			"ssl":              false,
+			"published_deploy": nil,
+			"force_ssl":        false,
+			"build_image":      "focal",
+			"processing_settings": map[string]any{
+				"skip":              true,
+				"ignore_html_forms": false,
+				"css":               map[string]any{"bundle": false, "minify": false},
+				"js":                map[string]any{"bundle": false, "minify": false},
+				"images":            map[string]any{"optimize": false},
+				"html":              map[string]any{"pretty_urls": true},
+			},
+		}
+		applySiteRequest(site, req)
+		s.put("sites", id, site)
+		writeJSON(rt.w, http.StatusCreated, site)
+	case len(rt.segments) == 2:
+		id := rt.segments[1]
+		site, ok := s.get("sites", id)
+		if !ok {
+			notFound(rt.w)
+			return
+		}
+		switch rt.r.Method {
+		case http.MethodGet:
+			writeJSON(rt.w, http.StatusOK, site)
+		case http.MethodPatch:
+			var req map[string]any
+			if !rt.decode(&req) {
+				return
+			}
+			applySiteRequest(site, req)
+			writeJSON(rt.w, http.StatusOK, site)
+		case http.MethodDelete:
+			delete(s.collections["sites"], id)
+			delete(s.collections["ssl"], id)
+			rt.w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(rt.w)
+		}
+	default:
+		notFound(rt.w)
+	}
+}
+
+// applySiteRequest merges a create or update request into site, the way
+// Netlify maps the "repo" object onto "build_settings".
+func applySiteRequest(site map[string]any, req map[string]any) {
+	for key, value := range req {
+		if key == "repo" {
+			continue
+		}
+		if value == "" && key == "name" {
+			continue
+		}
+		site[key] = value
+	}
+
+	if repo, ok := req["repo"]; ok && repo == nil {
+		delete(site, "build_settings")
+	}
+	if repo, ok := req["repo"].(map[string]any); ok {
+		settings := map[string]any{}
+		for key, value := range repo {
+			switch key {
+			case "repo":
+				settings["repo_path"] = value
+			case "branch":
+				settings["repo_branch"] = value
+			default:
+				settings[key] = value
+			}
+		}
+		site["build_settings"] = settings
+	}
+
+	name, _ := site["name"].(string)
+	site["url"] = "https://" + name + ".netlify.app"
+	site["ssl_url"] = site["url"]
+	site["admin_url"] = "https://app.netlify.com/sites/" + name
+	site["updated_at"] = now()
+}
+
+// handleSiteSSL serves the certificate of a site. A provisioned certificate
+// is reported pending once before being issued, so that callers have to wait
+// for it. A request body holding a certificate uploads a custom one instead.
+func (s *Server) handleSiteSSL(rt route, siteId string) {
+	site, ok := s.get("sites", siteId)
+	if !ok {
+		notFound(rt.w)
+		return
+	}
+
+	switch rt.r.Method {
+	case http.MethodPost:
+		body, err := io.ReadAll(rt.r.Body)
+		if err != nil {
+			writeError(rt.w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(body) > 0 {
+			s.uploadSiteSSL(rt, siteId, body)
+			return
+		}
+
+		customDomain, _ := site["custom_domain"].(string)
+		if customDomain == "" {
+			writeError(rt.w, http.StatusUnprocessableEntity, "Site has no custom domain")
+			return
+		}
+		domains := []any{customDomain}
+		if aliases, ok := site["domain_aliases"].([]any); ok {
+			domains = append(domains, aliases...)
+		}
+		ssl := map[string]any{
+			"state":      "pending",
+			"domains":    domains,
+			"created_at": now(),
+			"updated_at": now(),
+			"expires_at": time.Now().UTC().Add(90 * 24 * time.Hour).Format(time.RFC3339),
+		}
+		s.put("ssl", siteId, ssl)
+		writeJSON(rt.w, http.StatusOK, ssl)
+	case http.MethodGet:
+		ssl, ok := s.get("ssl", siteId)
+		if !ok {
+			notFound(rt.w)
+			return
+		}
+		writeJSON(rt.w, http.StatusOK, ssl)
+		if ssl["state"] == "pending" {
+			ssl["state"] = "issued"
+			site["ssl"] = true
+		}
+	default:
+		methodNotAllowed(rt.w)
+	}
+}
+
+// uploadSiteSSL stores a custom certificate, taking its domains and expiry
+// from the PEM certificate.
+func (s *Server) uploadSiteSSL(rt route, siteId string, body []byte) {
+	var req struct {
+		Certificate    string `json:"certificate"`
+		Key            string `json:"key"`
+		CACertificates string `json:"ca_certificates"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeError(rt.w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	block, _ := pem.Decode([]byte(req.Certificate))
+	if block == nil || req.Key == "" {
+		writeError(rt.w, http.StatusUnprocessableEntity, "Invalid certificate or key")
+		return
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		writeError(rt.w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	domains := []any{}
+	for _, name := range cert.DNSNames {
+		domains = append(domains, name)
+	}
+	ssl := map[string]any{
+		"state":      "custom",
+		"domains":    domains,
+		"created_at": now(),
+		"updated_at": now(),
+		"expires_at": cert.NotAfter.UTC().Format(time.RFC3339),
+	}
+	s.put("ssl", siteId, ssl)
+	s.collections["sites"][siteId]["ssl"] = true
+	writeJSON(rt.w, http.StatusOK, ssl)
+}
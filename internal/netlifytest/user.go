@@ -0,0 +1,16 @@
+package netlifytest
+
+import "net/http"
+
+func (s *Server) handleUser(rt route) {
+	if len(rt.segments) != 1 {
+		notFound(rt.w)
+		return
+	}
+	if rt.r.Method != http.MethodGet {
+		methodNotAllowed(rt.w)
+		return
+	}
+	user, _ := s.get("users", "me")
+	writeJSON(rt.w, http.StatusOK, user)
+}
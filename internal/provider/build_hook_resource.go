@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-netlify/internal/netlify"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &BuildHookResource{}
+	_ resource.ResourceWithImportState = &BuildHookResource{}
+	_ resource.ResourceWithConfigure   = &BuildHookResource{}
+)
+
+func NewBuildHookResource() resource.Resource {
+	return &BuildHookResource{}
+}
+
+// BuildHookResource defines the resource implementation.
+type BuildHookResource struct {
+	client *netlify.NetlifyClient
+}
+
+// BuildHookResourceModel describes the resource data model.
+type BuildHookResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	SiteId      types.String   `tfsdk:"site_id"`
+	Title       types.String   `tfsdk:"title"`
+	Branch      types.String   `tfsdk:"branch"`
+	Url         types.String   `tfsdk:"url"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *BuildHookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build_hook"
+}
+
+func (r *BuildHookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Build hook resource. A POST request to the hook URL triggers a build of the site",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the build hook",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Description: "ID of the site built by the hook",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Title of the build hook",
+				Required:    true,
+			},
+			"branch": schema.StringAttribute{
+				Description: "Branch built by the hook. Defaults to the production branch of the site",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the build hook. Anyone knowing it can trigger builds",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *BuildHookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BuildHookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BuildHookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	hook, err := r.client.CreateBuildHook(ctx, data.SiteId.ValueString(), data.buildHookRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Netlify Build Hook",
+			err.Error(),
+		)
+		return
+	}
+
+	data.refresh(hook)
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BuildHookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BuildHookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	hook, err := r.client.GetBuildHook(ctx, data.SiteId.ValueString(), data.Id.ValueString())
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify Build Hook not found, removing it from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Build Hook",
+			err.Error(),
+		)
+		return
+	}
+
+	data.refresh(hook)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BuildHookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BuildHookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	siteId := data.SiteId.ValueString()
+	hookId := data.Id.ValueString()
+	err := r.client.UpdateBuildHook(ctx, siteId, hookId, data.buildHookRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Netlify Build Hook",
+			err.Error(),
+		)
+		return
+	}
+
+	hook, err := r.client.GetBuildHook(ctx, siteId, hookId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Build Hook",
+			err.Error(),
+		)
+		return
+	}
+
+	data.refresh(hook)
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BuildHookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BuildHookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteBuildHook(ctx, data.SiteId.ValueString(), data.Id.ValueString())
+	if err != nil && !netlify.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Netlify Build Hook",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a hook from an ID of the form site_id:hook_id.
+func (r *BuildHookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	siteId, hookId, ok := strings.Cut(req.ID, ":")
+	if !ok || siteId == "" || hookId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier of the form site_id:hook_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), siteId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), hookId)...)
+}
+
+func (data *BuildHookResourceModel) buildHookRequest() netlify.BuildHookRequest {
+	return netlify.BuildHookRequest{
+		Title:  data.Title.ValueString(),
+		Branch: data.Branch.ValueString(),
+	}
+}
+
+// refresh sets the model from the hook returned by the API.
+func (data *BuildHookResourceModel) refresh(hook *netlify.BuildHook) {
+	data.Id = types.StringValue(hook.Id)
+	data.SiteId = types.StringValue(hook.SiteId)
+	data.Title = types.StringValue(hook.Title)
+	data.Branch = types.StringValue(hook.Branch)
+	data.Url = types.StringValue(hook.Url)
+	data.CreatedAt = types.StringValue(hook.CreatedAt)
+}
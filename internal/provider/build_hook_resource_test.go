@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBuildHookResource(t *testing.T) {
+	newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccBuildHookResourceConfig("CMS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_build_hook.test", "branch", "main"),
+					resource.TestCheckResourceAttrSet("netlify_build_hook.test", "url"),
+				),
+			},
+			{
+				ResourceName:            "netlify_build_hook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["netlify_build_hook.test"]
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["site_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				Config: testAccProviderConfig + testAccBuildHookResourceConfig("Headless CMS") + `
+data "netlify_build_hooks" "test" {
+  site_id = netlify_site.test.id
+
+  depends_on = [netlify_build_hook.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_build_hook.test", "title", "Headless CMS"),
+					resource.TestCheckResourceAttr("data.netlify_build_hooks.test", "hooks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netlify_build_hooks.test", "hooks.0.url", "netlify_build_hook.test", "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBuildHookResourceConfig(title string) string {
+	return `
+resource "netlify_site" "test" {
+  name = "hooked-site"
+}
+
+resource "netlify_build_hook" "test" {
+  site_id = netlify_site.test.id
+  title   = "` + title + `"
+}
+`
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-netlify/internal/netlify"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type BuildHooksDataSource struct {
+	client *netlify.NetlifyClient
+}
+
+type BuildHooksDataSourceModel struct {
+	SiteId types.String     `tfsdk:"site_id"`
+	Hooks  []buildHookModel `tfsdk:"hooks"`
+}
+
+type buildHookModel struct {
+	Id        types.String `tfsdk:"id"`
+	Title     types.String `tfsdk:"title"`
+	Branch    types.String `tfsdk:"branch"`
+	Url       types.String `tfsdk:"url"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+var (
+	_ datasource.DataSource              = &BuildHooksDataSource{}
+	_ datasource.DataSourceWithConfigure = &BuildHooksDataSource{}
+)
+
+func NewBuildHooksDataSource() datasource.DataSource {
+	return &BuildHooksDataSource{}
+}
+
+func (d *BuildHooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build_hooks"
+}
+
+func (d *BuildHooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BuildHooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Build hooks Datasource, listing the build hooks of a site",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				Description: "ID of the site",
+				Required:    true,
+			},
+			"hooks": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"title": schema.StringAttribute{
+							Computed: true,
+						},
+						"branch": schema.StringAttribute{
+							Computed: true,
+						},
+						"url": schema.StringAttribute{
+							Computed:  true,
+							Sensitive: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BuildHooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BuildHooksDataSourceModel
+	tflog.Debug(ctx, "Preparing to read Build Hooks data source")
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hooks, err := d.client.ListBuildHooks(ctx, data.SiteId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Build Hooks",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Hooks = []buildHookModel{}
+	for _, hook := range hooks {
+		data.Hooks = append(data.Hooks, buildHookModel{
+			Id:        types.StringValue(hook.Id),
+			Title:     types.StringValue(hook.Title),
+			Branch:    types.StringValue(hook.Branch),
+			Url:       types.StringValue(hook.Url),
+			CreatedAt: types.StringValue(hook.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-netlify/internal/netlify"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// buildLogTail is the number of lines of the log of a failed build shown in
+// the error.
+const buildLogTail = 20
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &BuildResource{}
+	_ resource.ResourceWithConfigure = &BuildResource{}
+)
+
+func NewBuildResource() resource.Resource {
+	return &BuildResource{}
+}
+
+// BuildResource defines the resource implementation.
+type BuildResource struct {
+	client *netlify.NetlifyClient
+}
+
+// BuildResourceModel describes the resource data model.
+type BuildResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	SiteId      types.String   `tfsdk:"site_id"`
+	ClearCache  types.Bool     `tfsdk:"clear_cache"`
+	Triggers    types.Map      `tfsdk:"triggers"`
+	DeployId    types.String   `tfsdk:"deploy_id"`
+	DeployState types.String   `tfsdk:"deploy_state"`
+	DeployUrl   types.String   `tfsdk:"deploy_url"`
+	Sha         types.String   `tfsdk:"sha"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *BuildResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build"
+}
+
+func (r *BuildResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Build of a site, waiting for the build and its deploy to be ready. " +
+			"A new build is started whenever the triggers change, destroying the resource leaves the build in the site history",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the build",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Description: "ID of the site to build",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"clear_cache": schema.BoolAttribute{
+				Description: "Whether to clear the build cache before building. Only applies to the builds started after a change",
+				Optional:    true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that start a new build when changed, e.g. the values of the environment variables used by the build",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"deploy_id": schema.StringAttribute{
+				Description: "ID of the deploy produced by the build",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deploy_state": schema.StringAttribute{
+				Description: "State of the deploy produced by the build",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deploy_url": schema.StringAttribute{
+				Description: "Unique URL of the deploy produced by the build",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha": schema.StringAttribute{
+				Description: "Commit built",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+		},
+	}
+}
+
+func (r *BuildResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BuildResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BuildResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	build, err := r.client.CreateSiteBuild(ctx, data.SiteId.ValueString(), netlify.BuildRequest{
+		ClearCache: data.ClearCache.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Netlify Build",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the build right away, so that a build failing or timing out is
+	// tainted and started again on the next apply.
+	data.refresh(build, nil)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	build, err = r.client.WaitForBuild(ctx, build.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Netlify Build",
+			err.Error(),
+		)
+		return
+	}
+	if build.Error != "" {
+		resp.Diagnostics.AddError(
+			"Netlify Build Failed",
+			r.buildFailure(ctx, build),
+		)
+		return
+	}
+
+	// Builds skipped or stopped by the site settings produce no deploy.
+	var deploy *netlify.Deploy
+	if build.DeployId != "" {
+		deploy, err = r.client.WaitForDeploy(ctx, build.DeployId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Netlify Build",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	data.refresh(build, deploy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BuildResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BuildResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	buildId := data.Id.ValueString()
+	build, err := r.client.GetBuild(ctx, buildId)
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify Build not found, removing it from state", map[string]any{"id": buildId})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Build",
+			err.Error(),
+		)
+		return
+	}
+
+	var deploy *netlify.Deploy
+	if build.DeployId != "" {
+		deploy, err = r.client.GetDeploy(ctx, build.DeployId)
+		if err != nil && !netlify.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Read Netlify Build",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	data.refresh(build, deploy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores clear_cache and the new timeouts, changing the triggers
+// starts a new build.
+func (r *BuildResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BuildResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the build from the state, builds are part of the
+// history of the site.
+func (r *BuildResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// buildFailure describes a failed build with the last lines of its log.
+func (r *BuildResource) buildFailure(ctx context.Context, build *netlify.Build) string {
+	msg := fmt.Sprintf("Build %s failed: %s", build.Id, build.Error)
+
+	lines, err := r.client.GetBuildLog(ctx, build.Id)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read the log of the failed Netlify Build", map[string]any{"id": build.Id, "error": err.Error()})
+		return msg
+	}
+	if len(lines) > buildLogTail {
+		lines = lines[len(lines)-buildLogTail:]
+	}
+
+	var log strings.Builder
+	for _, line := range lines {
+		log.WriteString("\n" + line.Message)
+	}
+	return msg + "\n\nBuild log:" + log.String()
+}
+
+// refresh sets the computed attributes from the build and, once known, its
+// deploy.
+func (data *BuildResourceModel) refresh(build *netlify.Build, deploy *netlify.Deploy) {
+	data.Id = types.StringValue(build.Id)
+	data.DeployId = optionalStringValue(build.DeployId)
+	data.Sha = optionalStringValue(build.Sha)
+	data.CreatedAt = types.StringValue(build.CreatedAt)
+
+	if deploy == nil {
+		if data.DeployState.IsUnknown() {
+			data.DeployState = types.StringNull()
+		}
+		if data.DeployUrl.IsUnknown() {
+			data.DeployUrl = types.StringNull()
+		}
+		return
+	}
+	data.DeployState = types.StringValue(deploy.State)
+	data.DeployUrl = types.StringValue(deploy.DeploySslUrl)
+}
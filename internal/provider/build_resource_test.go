@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBuildResource(t *testing.T) {
+	newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccBuildResourceConfig("npm run build", false, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netlify_build.test", "id"),
+					resource.TestCheckResourceAttrSet("netlify_build.test", "deploy_id"),
+					resource.TestCheckResourceAttr("netlify_build.test", "deploy_state", "ready"),
+				),
+			},
+			// Changing the triggers starts a new build.
+			{
+				Config: testAccProviderConfig + testAccBuildResourceConfig("npm run build", false, "2"),
+				Check:  resource.TestCheckResourceAttr("netlify_build.test", "triggers.version", "2"),
+			},
+			{
+				Config:      testAccProviderConfig + testAccBuildResourceConfig("npm run build && exit 1", false, "3"),
+				ExpectError: regexp.MustCompile(`Command failed with exit code 1`),
+			},
+			{
+				// Builds skipped by the site settings produce no deploy.
+				Config: testAccProviderConfig + testAccBuildResourceConfig("npm run build", true, "4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netlify_build.test", "id"),
+					resource.TestCheckNoResourceAttr("netlify_build.test", "deploy_id"),
+					resource.TestCheckNoResourceAttr("netlify_build.test", "deploy_state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBuildResourceConfig(cmd string, stopBuilds bool, version string) string {
+	return `
+resource "netlify_deploy_key" "test" {}
+
+resource "netlify_site" "test" {
+  name = "built-site"
+
+  repository = {
+    provider      = "github"
+    repo_path     = "netlify/test"
+    repo_branch   = "main"
+    deploy_key_id = netlify_deploy_key.test.id
+    cmd           = "` + cmd + `"
+    dir           = "public"
+    stop_builds   = ` + strconv.FormatBool(stopBuilds) + `
+  }
+}
+
+resource "netlify_build" "test" {
+  site_id     = netlify_site.test.id
+  clear_cache = true
+  triggers = {
+    version = "` + version + `"
+  }
+}
+`
+}
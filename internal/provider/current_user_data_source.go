@@ -106,7 +106,7 @@ func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	currentUser, err := d.client.GetCurrentUser()
+	currentUser, err := d.client.GetCurrentUser(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Netlify CurrentUser",
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-netlify/internal/netlify"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type DeployDataSource struct {
+	client *netlify.NetlifyClient
+}
+
+type DeployDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	SiteId       types.String `tfsdk:"site_id"`
+	State        types.String `tfsdk:"state"`
+	Branch       types.String `tfsdk:"branch"`
+	Context      types.String `tfsdk:"context"`
+	CommitRef    types.String `tfsdk:"commit_ref"`
+	Title        types.String `tfsdk:"title"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+	DeployUrl    types.String `tfsdk:"deploy_url"`
+	SslUrl       types.String `tfsdk:"ssl_url"`
+	Locked       types.Bool   `tfsdk:"locked"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+	PublishedAt  types.String `tfsdk:"published_at"`
+}
+
+var (
+	_ datasource.DataSource              = &DeployDataSource{}
+	_ datasource.DataSourceWithConfigure = &DeployDataSource{}
+)
+
+func NewDeployDataSource() datasource.DataSource {
+	return &DeployDataSource{}
+}
+
+func (d *DeployDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy"
+}
+
+func (d *DeployDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeployDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := deployDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "ID of the deploy",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Deploy Datasource",
+		Attributes:          attributes,
+	}
+}
+
+func (d *DeployDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeployDataSourceModel
+	tflog.Debug(ctx, "Preparing to read Deploy data source")
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploy, err := d.client.GetDeploy(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Deploy",
+			err.Error(),
+		)
+		return
+	}
+
+	data = deployDataSourceModelFrom(deploy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// deployDataSourceAttributes returns the attributes describing a deploy,
+// all computed.
+func deployDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID of the deploy",
+			Computed:    true,
+		},
+		"site_id": schema.StringAttribute{
+			Description: "ID of the site",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "State of the deploy, e.g. building, ready or error",
+			Computed:    true,
+		},
+		"branch": schema.StringAttribute{
+			Description: "Branch deployed",
+			Computed:    true,
+		},
+		"context": schema.StringAttribute{
+			Description: "Deploy context, e.g. production, deploy-preview or branch-deploy",
+			Computed:    true,
+		},
+		"commit_ref": schema.StringAttribute{
+			Description: "Commit deployed",
+			Computed:    true,
+		},
+		"title": schema.StringAttribute{
+			Computed: true,
+		},
+		"error_message": schema.StringAttribute{
+			Description: "Why the deploy failed, if it did",
+			Computed:    true,
+		},
+		"deploy_url": schema.StringAttribute{
+			Description: "Unique URL of the deploy",
+			Computed:    true,
+		},
+		"ssl_url": schema.StringAttribute{
+			Description: "URL of the site",
+			Computed:    true,
+		},
+		"locked": schema.BoolAttribute{
+			Description: "Whether the deploy is locked, stopping auto publishing",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Computed: true,
+		},
+		"updated_at": schema.StringAttribute{
+			Computed: true,
+		},
+		"published_at": schema.StringAttribute{
+			Description: "When the deploy was published, if it was",
+			Computed:    true,
+		},
+	}
+}
+
+func deployDataSourceModelFrom(deploy *netlify.Deploy) DeployDataSourceModel {
+	return DeployDataSourceModel{
+		Id:           types.StringValue(deploy.Id),
+		SiteId:       types.StringValue(deploy.SiteId),
+		State:        types.StringValue(deploy.State),
+		Branch:       optionalStringValue(deploy.Branch),
+		Context:      types.StringValue(deploy.Context),
+		CommitRef:    optionalStringValue(deploy.CommitRef),
+		Title:        optionalStringValue(deploy.Title),
+		ErrorMessage: optionalStringValue(deploy.ErrorMessage),
+		DeployUrl:    types.StringValue(deploy.DeploySslUrl),
+		SslUrl:       types.StringValue(deploy.SslUrl),
+		Locked:       types.BoolValue(deploy.Locked),
+		CreatedAt:    types.StringValue(deploy.CreatedAt),
+		UpdatedAt:    types.StringValue(deploy.UpdatedAt),
+		PublishedAt:  optionalStringValue(deploy.PublishedAt),
+	}
+}
@@ -9,10 +9,16 @@ import (
 	"terraform-provider-netlify/internal/netlify"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/ssh"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -34,10 +40,14 @@ type DeployKeyResource struct {
 
 // DeployKeyResourceModel describes the resource data model.
 type DeployKeyResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Key         types.String `tfsdk:"key"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	Id               types.String   `tfsdk:"id"`
+	Key              types.String   `tfsdk:"key"`
+	Fingerprint      types.String   `tfsdk:"fingerprint"`
+	KeyType          types.String   `tfsdk:"key_type"`
+	RotationTriggers types.Map      `tfsdk:"rotation_triggers"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *DeployKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,25 +57,68 @@ func (r *DeployKeyResource) Metadata(ctx context.Context, req resource.MetadataR
 func (r *DeployKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Deploy key resource",
+		MarkdownDescription: "Deploy key resource. Keys cannot be changed, rotating a key replaces the resource, " +
+			"either when the rotation triggers change or with terraform apply -replace",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "ID of the Netlify deploy key",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"key": schema.StringAttribute{
-				Description: "Nelify deploy key",
+				Description: "Public key of the deploy key, in OpenSSH authorized_keys format",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Description: "SHA256 fingerprint of the public key, as printed by ssh-keygen -l",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_type": schema.StringAttribute{
+				Description: "Algorithm of the public key, such as ssh-rsa or ssh-ed25519",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Description: "Arbitrary values that replace the key with a new one when changed, e.g. a rotation date",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Description: "Date of creation of the key",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -98,21 +151,32 @@ func (r *DeployKeyResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	deployKey, err := r.client.CreateDeployKey()
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	deployKey, err := r.client.CreateDeployKey(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create deploy_key, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(deployKey.Id)
-	data.Key = types.StringValue(deployKey.Key)
-	data.CreatedAt = types.StringValue(deployKey.CreatedAt)
+	// The key is saved even when it cannot be parsed, the error then taints
+	// it so that the next apply replaces it.
+	keyErr := data.refresh(deployKey)
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if keyErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("key"), "Invalid Netlify Deploy Key", keyErr.Error())
+	}
 }
 
 func (r *DeployKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -124,15 +188,29 @@ func (r *DeployKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	deployKey, err := r.client.GetDeployKey(data.Id.ValueString())
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	deployKey, err := r.client.GetDeployKey(ctx, data.Id.ValueString())
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify deploy key not found, removing it from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deploy_key, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(deployKey.Id)
-	data.Key = types.StringValue(deployKey.Key)
-	data.CreatedAt = types.StringValue(deployKey.CreatedAt)
+	// An unparsable key must not block the plan, or destroying it.
+	if err := data.refresh(deployKey); err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("key"), "Invalid Netlify Deploy Key", err.Error())
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -140,6 +218,8 @@ func (r *DeployKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
+// Update only stores the plan, every attribute of a deploy key either is
+// computed or requires a replacement, leaving nothing to send to the API.
 func (r *DeployKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data DeployKeyResourceModel
 
@@ -148,27 +228,7 @@ func (r *DeployKeyResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	var keyId string
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &keyId)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	deployKey, err := r.client.GetDeployKey(keyId)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update deploy_key, got error: %s", err))
-		return
-	}
-
-	data.Id = types.StringValue(deployKey.Id)
-	data.Key = types.StringValue(deployKey.Key)
-	data.CreatedAt = types.StringValue(deployKey.CreatedAt)
-	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
 }
 
 func (r *DeployKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -179,8 +239,16 @@ func (r *DeployKeyResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.client.DeleteDeployKey(data.Id.ValueString())
-	if err != nil {
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteDeployKey(ctx, data.Id.ValueString())
+	if err != nil && !netlify.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete deploy_key, got error: %s", err))
 		return
 	}
@@ -189,3 +257,22 @@ func (r *DeployKeyResource) Delete(ctx context.Context, req resource.DeleteReque
 func (r *DeployKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// refresh sets the model from the deploy key returned by the API, deriving
+// the fingerprint and type from its OpenSSH public key. They are left null
+// when the key cannot be parsed, the returned error telling why.
+func (data *DeployKeyResourceModel) refresh(deployKey *netlify.DeployKey) error {
+	data.Id = types.StringValue(deployKey.Id)
+	data.Key = types.StringValue(deployKey.Key)
+	data.CreatedAt = types.StringValue(deployKey.CreatedAt)
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(deployKey.Key))
+	if err != nil {
+		data.Fingerprint = types.StringNull()
+		data.KeyType = types.StringNull()
+		return fmt.Errorf("deploy key %s is not an OpenSSH public key: %w", deployKey.Id, err)
+	}
+	data.Fingerprint = types.StringValue(ssh.FingerprintSHA256(publicKey))
+	data.KeyType = types.StringValue(publicKey.Type())
+	return nil
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeployKeyResource(t *testing.T) {
+	srv := newTestServer(t)
+	var keyId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `resource "netlify_deploy_key" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("netlify_deploy_key.test", "id", func(value string) error {
+						keyId = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet("netlify_deploy_key.test", "key"),
+					resource.TestCheckResourceAttr("netlify_deploy_key.test", "key_type", "ssh-ed25519"),
+					resource.TestMatchResourceAttr("netlify_deploy_key.test", "fingerprint", regexp.MustCompile(`^SHA256:`)),
+				),
+			},
+			{
+				ResourceName:            "netlify_deploy_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				// Changing only the timeouts must not touch the key.
+				Config: testAccProviderConfig + `resource "netlify_deploy_key" "test" {
+  timeouts {
+    read = "5m"
+  }
+}`,
+				Check: resource.TestCheckResourceAttrWith("netlify_deploy_key.test", "id", func(value string) error {
+					if value != keyId {
+						return fmt.Errorf("expected deploy key %s to be kept, got %s", keyId, value)
+					}
+					return nil
+				}),
+			},
+			{
+				// Changing the rotation triggers must replace the key.
+				Config: testAccProviderConfig + `resource "netlify_deploy_key" "test" {
+  rotation_triggers = {
+    rotated_at = "2024-01-01"
+  }
+}`,
+				Check: resource.TestCheckResourceAttrWith("netlify_deploy_key.test", "id", func(value string) error {
+					if value == keyId {
+						return fmt.Errorf("expected deploy key %s to be replaced", keyId)
+					}
+					keyId = value
+					return nil
+				}),
+			},
+			{
+				// A public key that cannot be parsed must only warn, so that
+				// the key can still be planned and destroyed.
+				PreConfig: func() {
+					key := srv.Get("deploy_keys", keyId)
+					key["public_key"] = "not a key"
+					srv.Put("deploy_keys", keyId, key)
+				},
+				Config: testAccProviderConfig + `resource "netlify_deploy_key" "test" {
+  rotation_triggers = {
+    rotated_at = "2024-01-01"
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_deploy_key.test", "key", "not a key"),
+					resource.TestCheckNoResourceAttr("netlify_deploy_key.test", "fingerprint"),
+				),
+			},
+			{
+				// Deleting the key out of band must plan a re-create.
+				PreConfig: func() {
+					srv.Remove("deploy_keys", keyId)
+				},
+				Config:             testAccProviderConfig + `resource "netlify_deploy_key" "test" {}`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,440 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-netlify/internal/netlify"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DeployNotificationResource{}
+	_ resource.ResourceWithImportState = &DeployNotificationResource{}
+	_ resource.ResourceWithConfigure   = &DeployNotificationResource{}
+	_ resource.ResourceWithModifyPlan  = &DeployNotificationResource{}
+)
+
+func NewDeployNotificationResource() resource.Resource {
+	return &DeployNotificationResource{}
+}
+
+// DeployNotificationResource defines the resource implementation.
+type DeployNotificationResource struct {
+	client *netlify.NetlifyClient
+}
+
+// DeployNotificationResourceModel describes the resource data model.
+type DeployNotificationResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	SiteId      types.String   `tfsdk:"site_id"`
+	Type        types.String   `tfsdk:"type"`
+	Event       types.String   `tfsdk:"event"`
+	Data        types.Map      `tfsdk:"data"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DeployNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy_notification"
+}
+
+func (r *DeployNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Deploy notification resource, notifying a URL, a Slack channel or an email address of the events of a site",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the notification",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Description: "ID of the site",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the notification, e.g. url, slack or email",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"event": schema.StringAttribute{
+				Description: "Event notified, e.g. deploy_created, deploy_building, deploy_failed or submission_created",
+				Required:    true,
+			},
+			"data": schema.MapAttribute{
+				Description: "Settings of the notification, depending on its type, e.g. url for url and slack notifications or email for email notifications",
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DeployNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the type, event and data keys against the hook types
+// supported by Netlify, so that mistakes are reported at plan time.
+func (r *DeployNotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data DeployNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Type.IsUnknown() || data.Event.IsUnknown() || data.Data.IsUnknown() {
+		return
+	}
+
+	// Only changes are validated, sparing a call to the API on every plan.
+	if !req.State.Raw.IsNull() {
+		var state DeployNotificationResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if data.Type.Equal(state.Type) && data.Event.Equal(state.Event) && data.Data.Equal(state.Data) {
+			return
+		}
+	}
+
+	hookTypes, err := r.client.ListHookTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Hook Types",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate(hookTypes)...)
+}
+
+func (r *DeployNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeployNotificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	hookReq, diags := data.hookRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, err := r.client.CreateHook(ctx, data.SiteId.ValueString(), hookReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Netlify Deploy Notification",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, hook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeployNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeployNotificationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	hookId := data.Id.ValueString()
+	hook, err := r.client.GetHook(ctx, hookId)
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify Deploy Notification not found, removing it from state", map[string]any{"id": hookId})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Deploy Notification",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, hook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeployNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeployNotificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	hookReq, diags := data.hookRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, err := r.client.UpdateHook(ctx, data.Id.ValueString(), hookReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Netlify Deploy Notification",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, hook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeployNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeployNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteHook(ctx, data.Id.ValueString())
+	if err != nil && !netlify.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Netlify Deploy Notification",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *DeployNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validate reports the type, event or data keys not supported by hookTypes.
+func (data *DeployNotificationResourceModel) validate(hookTypes []netlify.HookType) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var hookType *netlify.HookType
+	var names []string
+	for i := range hookTypes {
+		names = append(names, hookTypes[i].Name)
+		if hookTypes[i].Name == data.Type.ValueString() {
+			hookType = &hookTypes[i]
+		}
+	}
+	if hookType == nil {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Unsupported Deploy Notification Type",
+			fmt.Sprintf("Type %q is not supported by Netlify, expected one of: %s.", data.Type.ValueString(), strings.Join(names, ", ")),
+		)
+		return diags
+	}
+
+	supported := false
+	for _, event := range hookType.Events {
+		if event == data.Event.ValueString() {
+			supported = true
+		}
+	}
+	if !supported {
+		diags.AddAttributeError(
+			path.Root("event"),
+			"Unsupported Deploy Notification Event",
+			fmt.Sprintf("Event %q is not supported by %s notifications, expected one of: %s.", data.Event.ValueString(), hookType.Name, strings.Join(hookType.Events, ", ")),
+		)
+	}
+
+	fields := map[string]bool{}
+	var fieldNames []string
+	for _, field := range hookType.Fields {
+		fields[field.Name] = true
+		fieldNames = append(fieldNames, field.Name)
+		value, ok := data.Data.Elements()[field.Name]
+		if field.Required && (!ok || value.IsNull()) {
+			diags.AddAttributeError(
+				path.Root("data"),
+				"Missing Deploy Notification Data",
+				fmt.Sprintf("%s notifications require %s in data.", hookType.Name, field.Name),
+			)
+		}
+	}
+	var unknownKeys []string
+	for key := range data.Data.Elements() {
+		if !fields[key] {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+	sort.Strings(unknownKeys)
+	for _, key := range unknownKeys {
+		diags.AddAttributeError(
+			path.Root("data"),
+			"Unsupported Deploy Notification Data",
+			fmt.Sprintf("%s notifications do not use %s, expected: %s.", hookType.Name, key, strings.Join(fieldNames, ", ")),
+		)
+	}
+	return diags
+}
+
+func (data *DeployNotificationResourceModel) hookRequest(ctx context.Context) (netlify.HookRequest, diag.Diagnostics) {
+	req := netlify.HookRequest{
+		Type:  data.Type.ValueString(),
+		Event: data.Event.ValueString(),
+	}
+	diags := data.Data.ElementsAs(ctx, &req.Data, false)
+	return req, diags
+}
+
+// refresh sets the model from the hook returned by the API. Only the data
+// keys already in the model are kept: Netlify adds settings of its own, which
+// would otherwise show up as a diff against the configuration. Every key is
+// kept when the model has no data yet, e.g. on import.
+func (data *DeployNotificationResourceModel) refresh(ctx context.Context, hook *netlify.Hook) diag.Diagnostics {
+	managed := data.Data.Elements()
+	hookData := make(map[string]string, len(hook.Data))
+	for key, value := range hook.Data {
+		if _, ok := managed[key]; len(managed) > 0 && !ok {
+			continue
+		}
+		switch value := value.(type) {
+		case nil:
+		case string:
+			hookData[key] = value
+		default:
+			hookData[key] = fmt.Sprint(value)
+		}
+	}
+	dataValue, diags := types.MapValueFrom(ctx, types.StringType, hookData)
+
+	data.Id = types.StringValue(hook.Id)
+	data.SiteId = types.StringValue(hook.SiteId)
+	data.Type = types.StringValue(hook.Type)
+	data.Event = types.StringValue(hook.Event)
+	data.Data = dataValue
+	data.CreatedAt = types.StringValue(hook.CreatedAt)
+	return diags
+}
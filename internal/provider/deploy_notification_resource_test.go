@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"terraform-provider-netlify/internal/netlifytest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeployNotificationResource(t *testing.T) {
+	srv := newTestServer(t)
+	var hookId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDeployNotificationResourceConfig("deploy_failed", `url = "https://hooks.example.com/netlify"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_deploy_notification.test", "type", "url"),
+					resource.TestCheckResourceAttr("netlify_deploy_notification.test", "data.url", "https://hooks.example.com/netlify"),
+					resource.TestCheckResourceAttrWith("netlify_deploy_notification.test", "id", func(value string) error {
+						hookId = value
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            "netlify_deploy_notification.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccProviderConfig + testAccDeployNotificationResourceConfig("deploy_created", `url = "https://hooks.example.com/netlify"`),
+				Check:  resource.TestCheckResourceAttr("netlify_deploy_notification.test", "event", "deploy_created"),
+			},
+			{
+				Config:      testAccProviderConfig + testAccDeployNotificationResourceConfig("deploy_exploded", `url = "https://hooks.example.com/netlify"`),
+				ExpectError: regexp.MustCompile(`Event "deploy_exploded" is not supported by url notifications`),
+			},
+			{
+				Config:      testAccProviderConfig + testAccDeployNotificationResourceConfig("deploy_failed", `email = "ops@example.com"`),
+				ExpectError: regexp.MustCompile("url notifications require url in data"),
+			},
+			{
+				// Settings added by Netlify must not show up as a diff, and
+				// an unchanged notification must not be validated again.
+				PreConfig: func() {
+					hook := srv.Get("hooks", hookId)
+					hook["data"].(map[string]any)["signature_secret"] = nil
+					hook["data"].(map[string]any)["verified"] = true
+					srv.Put("hooks", hookId, hook)
+					srv.AddFault(netlifytest.Fault{Method: http.MethodGet, PathPrefix: "hooks/types", StatusCode: http.StatusInternalServerError, Times: 3})
+				},
+				Config:   testAccProviderConfig + testAccDeployNotificationResourceConfig("deploy_created", `url = "https://hooks.example.com/netlify"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccDeployNotificationResourceConfig(event string, data string) string {
+	return `
+resource "netlify_site" "test" {
+  name = "notified-site"
+}
+
+resource "netlify_deploy_notification" "test" {
+  site_id = netlify_site.test.id
+  type    = "url"
+  event   = "` + event + `"
+  data = {
+    ` + data + `
+  }
+}
+`
+}
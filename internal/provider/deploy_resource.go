@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"terraform-provider-netlify/internal/netlify"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &DeployResource{}
+	_ resource.ResourceWithConfigure        = &DeployResource{}
+	_ resource.ResourceWithConfigValidators = &DeployResource{}
+	_ resource.ResourceWithModifyPlan       = &DeployResource{}
+)
+
+func NewDeployResource() resource.Resource {
+	return &DeployResource{}
+}
+
+// DeployResource defines the resource implementation.
+type DeployResource struct {
+	client *netlify.NetlifyClient
+}
+
+// DeployResourceModel describes the resource data model.
+type DeployResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	SiteId             types.String   `tfsdk:"site_id"`
+	Directory          types.String   `tfsdk:"directory"`
+	FunctionsDirectory types.String   `tfsdk:"functions_directory"`
+	SourceZip          types.String   `tfsdk:"source_zip"`
+	Draft              types.Bool     `tfsdk:"draft"`
+	Title              types.String   `tfsdk:"title"`
+	ContentHash        types.String   `tfsdk:"content_hash"`
+	State              types.String   `tfsdk:"state"`
+	DeployUrl          types.String   `tfsdk:"deploy_url"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DeployResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy"
+}
+
+func (r *DeployResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Deploy of a local directory, uploading only the files Netlify does not have yet, or of a zip archive. " +
+			"A new deploy is created whenever the content changes, destroying the resource leaves the deploy in the site history",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the deploy",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Description: "ID of the site to deploy",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				Description: "Local directory to publish. Hidden files are skipped, except for the .well-known directory. Exactly one of directory or source_zip must be set",
+				Optional:    true,
+			},
+			"functions_directory": schema.StringAttribute{
+				Description: "Local directory holding the zip archives of the functions to deploy, each function being named after its archive",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("source_zip")),
+				},
+			},
+			"source_zip": schema.StringAttribute{
+				Description: "Local zip archive of the files to publish, uploaded as a whole. Exactly one of directory or source_zip must be set",
+				Optional:    true,
+			},
+			"draft": schema.BoolAttribute{
+				Description: "Whether to create a draft deploy, reachable on its own URL without being published",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Title of the deploy, shown in the deploy list",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "Hash of the content of the directories or of the zip archive, computed at plan time",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the deploy",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deploy_url": schema.StringAttribute{
+				Description: "Unique URL of the deploy",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+		},
+	}
+}
+
+func (r *DeployResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeployResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("directory"),
+			path.MatchRoot("source_zip"),
+		),
+	}
+}
+
+// ModifyPlan hashes the content of the directories or of the archive, so
+// that a new deploy is only planned when the content changes.
+func (r *DeployResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data DeployResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var contentHash types.String
+	if data.Directory.IsUnknown() || data.FunctionsDirectory.IsUnknown() || data.SourceZip.IsUnknown() {
+		contentHash = types.StringUnknown()
+	} else {
+		content, diags := data.content()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		contentHash = types.StringValue(content.hash)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), contentHash)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state DeployResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !contentHash.Equal(state.ContentHash) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+	}
+}
+
+func (r *DeployResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeployResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	content, diags := data.content()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.ContentHash.IsUnknown() && data.ContentHash.ValueString() != content.hash {
+		resp.Diagnostics.AddError(
+			"Deploy Content Changed",
+			"The content to deploy changed between plan and apply. Please plan again.",
+		)
+		return
+	}
+	data.ContentHash = types.StringValue(content.hash)
+
+	var deploy *netlify.Deploy
+	var err error
+	siteId := data.SiteId.ValueString()
+	if content.archive != nil {
+		deploy, err = r.client.CreateZipDeploy(ctx, siteId, content.archive, data.Draft.ValueBool(), data.Title.ValueString())
+	} else {
+		deploy, err = r.client.CreateDigestDeploy(ctx, siteId, content.digest, data.Draft.ValueBool(), data.Title.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Netlify Deploy",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the deploy right away, so that a deploy failing or timing out is
+	// tainted and created again on the next apply.
+	data.refresh(deploy)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploy, err = r.client.WaitForDeploy(ctx, deploy.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Netlify Deploy",
+			err.Error(),
+		)
+		return
+	}
+
+	data.refresh(deploy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeployResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	deployId := data.Id.ValueString()
+	deploy, err := r.client.GetDeploy(ctx, deployId)
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify Deploy not found, removing it from state", map[string]any{"id": deployId})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Deploy",
+			err.Error(),
+		)
+		return
+	}
+
+	data.refresh(deploy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores the new paths and timeouts, changing the content
+// creates a new deploy.
+func (r *DeployResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeployResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the deploy from the state, deploys are part of the
+// history of the site.
+func (r *DeployResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// deployContent is what a deploy publishes, either the digests of
+// directories or a zip archive.
+type deployContent struct {
+	hash    string
+	digest  *netlify.DeployDigest
+	archive []byte
+}
+
+// content reads the configured directories or zip archive.
+func (data *DeployResourceModel) content() (*deployContent, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.SourceZip.IsNull() {
+		archive, err := os.ReadFile(data.SourceZip.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("source_zip"),
+				"Unable to Read Deploy Archive",
+				err.Error(),
+			)
+			return nil, diags
+		}
+		sum := sha256.Sum256(archive)
+		return &deployContent{hash: hex.EncodeToString(sum[:]), archive: archive}, diags
+	}
+
+	digest, err := netlify.DigestDirectory(data.Directory.ValueString(), data.FunctionsDirectory.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("directory"),
+			"Unable to Read Deploy Directory",
+			err.Error(),
+		)
+		return nil, diags
+	}
+	return &deployContent{hash: digest.Hash, digest: digest}, diags
+}
+
+// refresh sets the computed attributes from the deploy returned by the API.
+func (data *DeployResourceModel) refresh(deploy *netlify.Deploy) {
+	data.Id = types.StringValue(deploy.Id)
+	data.SiteId = types.StringValue(deploy.SiteId)
+	data.State = types.StringValue(deploy.State)
+	data.DeployUrl = types.StringValue(deploy.DeploySslUrl)
+	data.CreatedAt = types.StringValue(deploy.CreatedAt)
+}
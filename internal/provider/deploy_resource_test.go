@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeployResource(t *testing.T) {
+	newTestServer(t)
+
+	dir := t.TempDir()
+	functionsDir := t.TempDir()
+	testAccWriteFile(t, filepath.Join(dir, "index.html"), "<h1>Hello</h1>")
+	testAccWriteFile(t, filepath.Join(functionsDir, "hello.zip"), "PK")
+
+	var contentHash string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDeployResourceConfig(dir, functionsDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_deploy.test", "state", "ready"),
+					resource.TestCheckResourceAttrSet("netlify_deploy.test", "deploy_url"),
+					resource.TestCheckResourceAttrWith("netlify_deploy.test", "content_hash", func(value string) error {
+						contentHash = value
+						return nil
+					}),
+				),
+			},
+			// Unchanged content plans no new deploy.
+			{
+				Config:   testAccProviderConfig + testAccDeployResourceConfig(dir, functionsDir),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccWriteFile(t, filepath.Join(dir, "index.html"), "<h1>Hello again</h1>")
+				},
+				Config: testAccProviderConfig + testAccDeployResourceConfig(dir, functionsDir),
+				Check: resource.TestCheckResourceAttrWith("netlify_deploy.test", "content_hash", func(value string) error {
+					if value == contentHash {
+						return errors.New("expected the content hash to change")
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccDeployResourceZip(t *testing.T) {
+	newTestServer(t)
+
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "site.zip")
+	testAccWriteZip(t, archive, "<h1>Hello</h1>")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDeployResourceZipConfig(archive),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_deploy.test", "state", "ready"),
+					resource.TestCheckResourceAttrSet("netlify_deploy.test", "content_hash"),
+				),
+			},
+			{
+				Config:   testAccProviderConfig + testAccDeployResourceZipConfig(archive),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccWriteZip(t, archive, "<h1>Hello again</h1>")
+				},
+				Config:             testAccProviderConfig + testAccDeployResourceZipConfig(archive),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccProviderConfig + `
+resource "netlify_deploy" "test" {
+  site_id    = "1"
+  directory  = "` + filepath.ToSlash(dir) + `"
+  source_zip = "` + filepath.ToSlash(archive) + `"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccWriteZip(t *testing.T, path string, index string) {
+	t.Helper()
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	f, err := archive.Create("index.html")
+	if err == nil {
+		_, err = f.Write([]byte(index))
+	}
+	if err == nil {
+		err = archive.Close()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	testAccWriteFile(t, path, buf.String())
+}
+
+func testAccWriteFile(t *testing.T, path string, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDeployResourceZipConfig(archive string) string {
+	return `
+resource "netlify_site" "test" {
+  name = "zipped-site"
+}
+
+resource "netlify_deploy" "test" {
+  site_id    = netlify_site.test.id
+  source_zip = "` + filepath.ToSlash(archive) + `"
+}
+`
+}
+
+func testAccDeployResourceConfig(dir string, functionsDir string) string {
+	return `
+resource "netlify_site" "test" {
+  name = "deployed-site"
+}
+
+resource "netlify_deploy" "test" {
+  site_id             = netlify_site.test.id
+  directory           = "` + filepath.ToSlash(dir) + `"
+  functions_directory = "` + filepath.ToSlash(functionsDir) + `"
+}
+`
+}
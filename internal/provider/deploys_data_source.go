@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-netlify/internal/netlify"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type DeploysDataSource struct {
+	client *netlify.NetlifyClient
+}
+
+type DeploysDataSourceModel struct {
+	SiteId     types.String            `tfsdk:"site_id"`
+	State      types.String            `tfsdk:"state"`
+	Branch     types.String            `tfsdk:"branch"`
+	Context    types.String            `tfsdk:"context"`
+	Production types.Bool              `tfsdk:"production"`
+	MaxItems   types.Int64             `tfsdk:"max_items"`
+	Deploys    []DeployDataSourceModel `tfsdk:"deploys"`
+}
+
+// defaultDeploysMaxItems bounds the deploys listed when max_items is not set,
+// active sites having thousands of them.
+const defaultDeploysMaxItems = 100
+
+var (
+	_ datasource.DataSource              = &DeploysDataSource{}
+	_ datasource.DataSourceWithConfigure = &DeploysDataSource{}
+)
+
+func NewDeploysDataSource() datasource.DataSource {
+	return &DeploysDataSource{}
+}
+
+func (d *DeploysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploys"
+}
+
+func (d *DeploysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Deploys Datasource, listing the deploys of a site, most recent first",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				Description: "ID of the site",
+				Required:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only return deploys in this state, e.g. ready or error",
+				Optional:    true,
+			},
+			"branch": schema.StringAttribute{
+				Description: "Only return deploys of this branch",
+				Optional:    true,
+			},
+			"context": schema.StringAttribute{
+				Description: "Only return deploys of this context, e.g. production, deploy-preview or branch-deploy. " +
+					"The API cannot filter on it, so deploys are listed until max_items of them match",
+				Optional: true,
+			},
+			"production": schema.BoolAttribute{
+				Description: "Only return production deploys when true, and the other deploys when false",
+				Optional:    true,
+			},
+			"max_items": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of deploys returned. Defaults to %d", defaultDeploysMaxItems),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"deploys": schema.ListNestedAttribute{
+				Description: "Deploys found, most recent first",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: deployDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploysDataSourceModel
+	tflog.Debug(ctx, "Preparing to read Deploys data source")
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := netlify.ListDeploysParams{
+		State:   data.State.ValueString(),
+		Branch:  data.Branch.ValueString(),
+		Context: data.Context.ValueString(),
+	}
+	if !data.Production.IsNull() {
+		params.Production = data.Production.ValueBoolPointer()
+	}
+	opts := netlify.ListOptions{MaxItems: defaultDeploysMaxItems}
+	if !data.MaxItems.IsNull() {
+		opts.MaxItems = int(data.MaxItems.ValueInt64())
+	}
+	deploys, err := d.client.ListDeploys(ctx, data.SiteId.ValueString(), params, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Netlify Deploys",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Deploys = []DeployDataSourceModel{}
+	for i := range deploys {
+		data.Deploys = append(data.Deploys, deployDataSourceModelFrom(&deploys[i]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
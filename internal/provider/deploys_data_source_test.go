@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploysDataSource(t *testing.T) {
+	newTestServer(t)
+
+	production := t.TempDir()
+	draft := t.TempDir()
+	testAccWriteFile(t, filepath.Join(production, "index.html"), "<h1>Production</h1>")
+	testAccWriteFile(t, filepath.Join(draft, "index.html"), "<h1>Draft</h1>")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `
+resource "netlify_site" "test" {
+  name = "listed-site"
+}
+
+resource "netlify_deploy" "production" {
+  site_id   = netlify_site.test.id
+  directory = "` + filepath.ToSlash(production) + `"
+  title     = "production"
+}
+
+resource "netlify_deploy" "draft" {
+  site_id   = netlify_site.test.id
+  directory = "` + filepath.ToSlash(draft) + `"
+  draft     = true
+}
+
+data "netlify_deploys" "production" {
+  site_id    = netlify_site.test.id
+  production = true
+  state      = "ready"
+
+  depends_on = [netlify_deploy.production, netlify_deploy.draft]
+}
+
+data "netlify_deploys" "preview" {
+  site_id = netlify_site.test.id
+  context = "deploy-preview"
+
+  depends_on = [netlify_deploy.production, netlify_deploy.draft]
+}
+
+data "netlify_deploys" "latest" {
+  site_id   = netlify_site.test.id
+  max_items = 1
+
+  depends_on = [netlify_deploy.production, netlify_deploy.draft]
+}
+
+data "netlify_deploy" "test" {
+  id = netlify_deploy.production.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netlify_deploys.production", "deploys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netlify_deploys.production", "deploys.0.id", "netlify_deploy.production", "id"),
+					resource.TestCheckResourceAttr("data.netlify_deploys.preview", "deploys.#", "0"),
+					resource.TestCheckResourceAttr("data.netlify_deploys.latest", "deploys.#", "1"),
+					resource.TestCheckResourceAttr("data.netlify_deploy.test", "title", "production"),
+					resource.TestCheckResourceAttr("data.netlify_deploy.test", "state", "ready"),
+					resource.TestCheckResourceAttrPair("data.netlify_deploy.test", "deploy_url", "netlify_deploy.production", "deploy_url"),
+					resource.TestCheckResourceAttrSet("data.netlify_deploy.test", "published_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,431 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-netlify/internal/netlify"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &DNSRecordResource{}
+	_ resource.ResourceWithImportState    = &DNSRecordResource{}
+	_ resource.ResourceWithConfigure      = &DNSRecordResource{}
+	_ resource.ResourceWithValidateConfig = &DNSRecordResource{}
+)
+
+func NewDNSRecordResource() resource.Resource {
+	return &DNSRecordResource{}
+}
+
+// DNSRecordResource defines the resource implementation.
+type DNSRecordResource struct {
+	client *netlify.NetlifyClient
+}
+
+// DNSRecordResourceModel describes the resource data model.
+type DNSRecordResourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	ZoneId   types.String   `tfsdk:"zone_id"`
+	Type     types.String   `tfsdk:"type"`
+	Hostname types.String   `tfsdk:"hostname"`
+	Value    types.String   `tfsdk:"value"`
+	TTL      types.Int64    `tfsdk:"ttl"`
+	Priority types.Int64    `tfsdk:"priority"`
+	Weight   types.Int64    `tfsdk:"weight"`
+	Port     types.Int64    `tfsdk:"port"`
+	Flag     types.Int64    `tfsdk:"flag"`
+	Tag      types.String   `tfsdk:"tag"`
+	Managed  types.Bool     `tfsdk:"managed"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// dnsRecordTypeAttributes lists the type specific attributes each record
+// type requires. Other types accept none of them.
+var dnsRecordTypeAttributes = map[string][]string{
+	"MX":  {"priority"},
+	"SRV": {"priority", "weight", "port"},
+	"CAA": {"flag", "tag"},
+}
+
+func (r *DNSRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNS record resource. Netlify DNS records cannot be modified, any change replaces the record",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the DNS record",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "ID of the DNS zone of the record",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the record, one of A, AAAA, CNAME, MX, TXT, NS, SRV, CAA, NETLIFY or NETLIFYv6",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "AAAA", "CNAME", "MX", "TXT", "NS", "SRV", "CAA", "NETLIFY", "NETLIFYv6"),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Description: "Fully qualified name of the record, e.g. www.example.com",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the record. For CAA records, the value of the property, e.g. letsencrypt.org",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "Time to live of the record in seconds. Defaults to 3600",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description: "Priority of MX and SRV records",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"weight": schema.Int64Attribute{
+				Description: "Weight of SRV records",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port of SRV records",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"flag": schema.Int64Attribute{
+				Description: "Flag of CAA records, 0 or 128 for critical properties",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Description: "Tag of CAA records, one of issue, issuewild or iodef",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("issue", "issuewild", "iodef"),
+				},
+			},
+			"managed": schema.BoolAttribute{
+				Description: "Whether the record is managed by Netlify",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DNSRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the type specific attributes are set for, and
+// only for, the record types using them.
+func (r *DNSRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSRecordResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	recordType := data.Type.ValueString()
+	required := map[string]bool{}
+	for _, name := range dnsRecordTypeAttributes[recordType] {
+		required[name] = true
+	}
+
+	values := map[string]attr.Value{
+		"priority": data.Priority,
+		"weight":   data.Weight,
+		"port":     data.Port,
+		"flag":     data.Flag,
+		"tag":      data.Tag,
+	}
+	for name, value := range values {
+		// Unknown values, e.g. computed by another resource, are only checked
+		// once known.
+		if value.IsUnknown() {
+			continue
+		}
+		switch {
+		case required[name] && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing DNS Record Attribute",
+				fmt.Sprintf("%s records require %s.", recordType, name),
+			)
+		case !required[name] && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unexpected DNS Record Attribute",
+				fmt.Sprintf("%s records do not use %s.", recordType, name),
+			)
+		}
+	}
+}
+
+func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	record, err := r.client.CreateDNSRecord(ctx, data.ZoneId.ValueString(), netlify.DNSRecordRequest{
+		Type:     data.Type.ValueString(),
+		Hostname: data.Hostname.ValueString(),
+		Value:    data.Value.ValueString(),
+		TTL:      data.TTL.ValueInt64(),
+		Priority: data.Priority.ValueInt64Pointer(),
+		Weight:   data.Weight.ValueInt64Pointer(),
+		Port:     data.Port.ValueInt64Pointer(),
+		Flag:     data.Flag.ValueInt64Pointer(),
+		Tag:      data.Tag.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Netlify DNS Record",
+			err.Error(),
+		)
+		return
+	}
+
+	data.refresh(record)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	record, err := r.client.GetDNSRecord(ctx, data.ZoneId.ValueString(), data.Id.ValueString())
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify DNS Record not found, removing it from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify DNS Record",
+			err.Error(),
+		)
+		return
+	}
+
+	data.refresh(record)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores the new timeouts, every other change replaces the
+// record.
+func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSRecordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSRecordResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteDNSRecord(ctx, data.ZoneId.ValueString(), data.Id.ValueString())
+	if err != nil && !netlify.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Netlify DNS Record",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a record from an ID of the form zone_id:record_id.
+func (r *DNSRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zoneId, recordId, ok := strings.Cut(req.ID, ":")
+	if !ok || zoneId == "" || recordId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier of the form zone_id:record_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), recordId)...)
+}
+
+// refresh sets the model from the record returned by the API. Attributes the
+// record type does not use are left null.
+func (data *DNSRecordResourceModel) refresh(record *netlify.DNSRecord) {
+	data.Id = types.StringValue(record.Id)
+	data.ZoneId = types.StringValue(record.DNSZoneId)
+	data.Type = types.StringValue(record.Type)
+	data.Hostname = types.StringValue(record.Hostname)
+	data.Value = types.StringValue(record.Value)
+	data.TTL = types.Int64Value(record.TTL)
+	data.Managed = types.BoolValue(record.Managed)
+
+	data.Priority = types.Int64Null()
+	data.Weight = types.Int64Null()
+	data.Port = types.Int64Null()
+	data.Flag = types.Int64Null()
+	data.Tag = types.StringNull()
+	for _, name := range dnsRecordTypeAttributes[record.Type] {
+		switch name {
+		case "priority":
+			data.Priority = types.Int64Value(record.Priority)
+		case "weight":
+			data.Weight = types.Int64Value(record.Weight)
+		case "port":
+			data.Port = types.Int64Value(record.Port)
+		case "flag":
+			data.Flag = types.Int64Value(record.Flag)
+		case "tag":
+			data.Tag = types.StringValue(record.Tag)
+		}
+	}
+}
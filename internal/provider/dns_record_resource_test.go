@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSRecordResource(t *testing.T) {
+	newTestServer(t)
+	var firstId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDNSRecordResourceConfig("10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("netlify_dns_record.mx", "id", func(value string) error {
+						firstId = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("netlify_dns_record.mx", "priority", "10"),
+					resource.TestCheckResourceAttr("netlify_dns_record.mx", "ttl", "3600"),
+					resource.TestCheckResourceAttr("netlify_dns_record.srv", "port", "5060"),
+					resource.TestCheckResourceAttr("netlify_dns_record.caa", "tag", "issue"),
+					resource.TestCheckNoResourceAttr("netlify_dns_record.a", "priority"),
+				),
+			},
+			{
+				ResourceName:      "netlify_dns_record.srv",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["netlify_dns_record.srv"]
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["zone_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				// Records are immutable, a new priority creates a new record.
+				Config: testAccProviderConfig + testAccDNSRecordResourceConfig("20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_dns_record.mx", "priority", "20"),
+					resource.TestCheckResourceAttrWith("netlify_dns_record.mx", "id", func(value string) error {
+						if value == firstId {
+							return fmt.Errorf("expected the record to be replaced")
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccProviderConfig + testAccDNSZoneResourceConfig + `
+resource "netlify_dns_record" "invalid" {
+  zone_id  = netlify_dns_zone.test.id
+  type     = "A"
+  hostname = "example.com"
+  value    = "192.0.2.1"
+  priority = 10
+}
+`,
+				ExpectError: regexp.MustCompile("A records do not use priority"),
+			},
+		},
+	})
+}
+
+func testAccDNSRecordResourceConfig(priority string) string {
+	return testAccDNSZoneResourceConfig + `
+resource "netlify_dns_record" "a" {
+  zone_id  = netlify_dns_zone.test.id
+  type     = "A"
+  hostname = "example.com"
+  value    = "192.0.2.1"
+}
+
+resource "netlify_dns_record" "mx" {
+  zone_id  = netlify_dns_zone.test.id
+  type     = "MX"
+  hostname = "example.com"
+  value    = "mail.example.com"
+  priority = ` + priority + `
+}
+
+resource "netlify_dns_record" "srv" {
+  zone_id  = netlify_dns_zone.test.id
+  type     = "SRV"
+  hostname = "_sip._tcp.example.com"
+  value    = "sip.example.com"
+  priority = 10
+  weight   = 5
+  port     = 5060
+  ttl      = 300
+}
+
+resource "netlify_dns_record" "caa" {
+  zone_id  = netlify_dns_zone.test.id
+  type     = "CAA"
+  hostname = "example.com"
+  value    = "letsencrypt.org"
+  flag     = 0
+  tag      = "issue"
+}
+`
+}
+
+func TestDNSRecordResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := NewDNSRecordResource()
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := func(recordType string, priority tftypes.Value) tfsdk.Config {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["type"] = tftypes.NewValue(tftypes.String, recordType)
+		values["priority"] = priority
+		return tfsdk.Config{Raw: tftypes.NewValue(objectType, values), Schema: schemaResp.Schema}
+	}
+
+	tests := map[string]struct {
+		config  tfsdk.Config
+		wantErr bool
+	}{
+		"unknown priority on A": {
+			config: config("A", tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)),
+		},
+		"unknown priority on MX": {
+			config: config("MX", tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)),
+		},
+		"priority on A": {
+			config:  config("A", tftypes.NewValue(tftypes.Number, 10)),
+			wantErr: true,
+		},
+		"missing priority on MX": {
+			config:  config("MX", tftypes.NewValue(tftypes.Number, nil)),
+			wantErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &fwresource.ValidateConfigResponse{}
+			r.(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: test.config}, resp)
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Errorf("expected error %t, got %v", test.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-netlify/internal/netlify"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type DNSZoneDataSource struct {
+	client *netlify.NetlifyClient
+}
+
+type DNSZoneDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	SiteId      types.String `tfsdk:"site_id"`
+	DNSServers  types.List   `tfsdk:"dns_servers"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+var (
+	_ datasource.DataSource              = &DNSZoneDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSZoneDataSource{}
+)
+
+func NewDNSZoneDataSource() datasource.DataSource {
+	return &DNSZoneDataSource{}
+}
+
+func (d *DNSZoneDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (d *DNSZoneDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSZoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNS zone Datasource, looking a zone up by name",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Domain of the zone to look up, e.g. example.com",
+				Required:    true,
+			},
+			"account_slug": schema.StringAttribute{
+				Description: "Slug of the account to look the zone up in. Defaults to every account of the user",
+				Optional:    true,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"site_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"dns_servers": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *DNSZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSZoneDataSourceModel
+	tflog.Debug(ctx, "Preparing to read DNS Zone data source")
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zones, err := d.client.ListDNSZones(ctx, data.AccountSlug.ValueString(), netlify.ListOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify DNS Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	var found []*netlify.DNSZone
+	for i := range zones {
+		if strings.EqualFold(zones[i].Name, data.Name.ValueString()) {
+			found = append(found, &zones[i])
+		}
+	}
+	switch len(found) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify DNS Zone",
+			fmt.Sprintf("no DNS zone found with name %q", data.Name.ValueString()),
+		)
+		return
+	case 1:
+	default:
+		accounts := make([]string, 0, len(found))
+		for _, zone := range found {
+			accounts = append(accounts, zone.AccountSlug)
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify DNS Zone",
+			fmt.Sprintf("%d DNS zones found with name %q (accounts %s), use account_slug to select one", len(found), data.Name.ValueString(), strings.Join(accounts, ", ")),
+		)
+		return
+	}
+	zone := found[0]
+
+	dnsServers, diags := dnsServersValue(ctx, zone)
+	resp.Diagnostics.Append(diags...)
+	data = DNSZoneDataSourceModel{
+		Id:          types.StringValue(zone.Id),
+		Name:        data.Name,
+		AccountSlug: types.StringValue(zone.AccountSlug),
+		SiteId:      types.StringValue(zone.SiteId),
+		DNSServers:  dnsServers,
+		CreatedAt:   types.StringValue(zone.CreatedAt),
+		UpdatedAt:   types.StringValue(zone.UpdatedAt),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSZoneResource(t *testing.T) {
+	srv := newTestServer(t)
+	var zoneId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDNSZoneResourceConfig + `
+data "netlify_dns_zone" "test" {
+  name = netlify_dns_zone.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("netlify_dns_zone.test", "id", func(value string) error {
+						zoneId = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("netlify_dns_zone.test", "account_slug", "netlifytest"),
+					resource.TestCheckResourceAttrPair("netlify_dns_zone.test", "site_id", "netlify_site.test", "id"),
+					resource.TestCheckResourceAttr("netlify_dns_zone.test", "dns_servers.#", "4"),
+					resource.TestCheckResourceAttrPair("data.netlify_dns_zone.test", "id", "netlify_dns_zone.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netlify_dns_zone.test", "dns_servers.0", "netlify_dns_zone.test", "dns_servers.0"),
+				),
+			},
+			{
+				ResourceName:      "netlify_dns_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing site_id must neither replace the zone nor
+				// delete its records.
+				Config: testAccProviderConfig + `
+resource "netlify_site" "test" {
+  name          = "zone-site"
+  custom_domain = "example.com"
+}
+
+resource "netlify_dns_zone" "test" {
+  name = "example.com"
+}
+`,
+				PlanOnly: true,
+			},
+			{
+				// A name found in several accounts must not pick one of them.
+				PreConfig: func() {
+					srv.Put("dns_zones", "other", map[string]any{
+						"id":           "other",
+						"name":         "example.com",
+						"account_slug": "other-team",
+					})
+				},
+				Config: testAccProviderConfig + testAccDNSZoneResourceConfig + `
+data "netlify_dns_zone" "test" {
+  name = netlify_dns_zone.test.name
+}
+`,
+				ExpectError: regexp.MustCompile(`2 DNS zones found with name "example.com"`),
+			},
+			{
+				// Deleting the zone in the UI must plan a re-create.
+				PreConfig: func() {
+					srv.Remove("dns_zones", zoneId)
+				},
+				Config:             testAccProviderConfig + testAccDNSZoneResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+const testAccDNSZoneResourceConfig = `
+resource "netlify_site" "test" {
+  name          = "zone-site"
+  custom_domain = "example.com"
+}
+
+resource "netlify_dns_zone" "test" {
+  name    = "example.com"
+  site_id = netlify_site.test.id
+}
+`
@@ -9,10 +9,17 @@ import (
 	"terraform-provider-netlify/internal/netlify"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -33,12 +40,20 @@ type EnvVarResource struct {
 
 // EnvVarsRessourceModel describes the resource data model.
 type EnvVarResourceModel struct {
-	AccountSlug types.String `tfsdk:"account_slug"`
-	SiteId      types.String `tfsdk:"site_id"`
-	Key         types.String `tfsdk:"key"`
-	Scopes      types.List   `tfsdk:"scopes"`
-	IsSecret    types.Bool   `tfsdk:"is_secret"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	AccountSlug types.String       `tfsdk:"account_slug"`
+	SiteId      types.String       `tfsdk:"site_id"`
+	Key         types.String       `tfsdk:"key"`
+	Scopes      types.List         `tfsdk:"scopes"`
+	Values      []envVarValueModel `tfsdk:"values"`
+	IsSecret    types.Bool         `tfsdk:"is_secret"`
+	LastUpdated types.String       `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value     `tfsdk:"timeouts"`
+}
+
+type envVarValueModel struct {
+	Value            types.String `tfsdk:"value"`
+	Context          types.String `tfsdk:"context"`
+	ContextParameter types.String `tfsdk:"context_parameter"`
 }
 
 func (r *EnvVarResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,15 +79,53 @@ func (r *EnvVarResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"values": schema.SetNestedAttribute{
+				Description: "Values of the variable, one per deploy context",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: "Value of the variable in this context",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"context": schema.StringAttribute{
+							Description: "Deploy context of the value: all, dev, branch-deploy, deploy-preview, production or branch",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("all", "dev", "branch-deploy", "deploy-preview", "production", "branch"),
+							},
+						},
+						"context_parameter": schema.StringAttribute{
+							Description: "Branch name when context is branch",
+							Optional:    true,
+						},
+					},
+				},
 			},
 			"is_secret": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -102,31 +155,41 @@ func (r *EnvVarResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	reqEnvVar := netlify.EnvVar{
-		Key: data.Key.ValueString(),
-		Values: []netlify.EnvVarValue{
-			{Value: "Terraform Placeholder"},
-		},
+		Key:      data.Key.ValueString(),
+		Values:   envVarValuesFromModel(data.Values),
 		IsSecret: data.IsSecret.ValueBool(),
 	}
 
-	for _, scope := range data.Scopes.Elements() {
-		reqEnvVar.Scopes = append(reqEnvVar.Scopes, scope.String())
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &reqEnvVar.Scopes, true)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	res, err := r.client.CreateEnvVar(data.AccountSlug.ValueString(), data.SiteId.ValueString(), reqEnvVar)
+	res, err := r.client.CreateEnvVar(ctx, data.AccountSlug.ValueString(), data.SiteId.ValueString(), reqEnvVar)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Netlify Env variable",
 			err.Error())
+		return
 	}
 
 	scopeList, diags := types.ListValueFrom(ctx, types.StringType, res.Scopes)
-	if diags.HasError() {
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.Scopes = scopeList
+	data.Values = envVarValuesToModel(res, data.Values)
 	data.IsSecret = types.BoolValue(res.IsSecret)
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -144,11 +207,25 @@ func (r *EnvVarResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	res, err := r.client.GetEnvVar(data.AccountSlug.ValueString(), data.SiteId.ValueString(), data.Key.ValueString())
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, err := r.client.GetEnvVar(ctx, data.AccountSlug.ValueString(), data.SiteId.ValueString(), data.Key.ValueString())
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify Env variable not found, removing it from state", map[string]any{"key": data.Key.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read Netlify Env variable",
 			err.Error())
+		return
 	}
 
 	scopeList, diags := types.ListValueFrom(ctx, types.StringType, res.Scopes)
@@ -158,6 +235,7 @@ func (r *EnvVarResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	data.Scopes = scopeList
+	data.Values = envVarValuesToModel(res, data.Values)
 	data.IsSecret = types.BoolValue(res.IsSecret)
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -167,46 +245,109 @@ func (r *EnvVarResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *EnvVarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data EnvVarResourceModel
+	var data, state EnvVarResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var slug, siteId, key string
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("account_slug"), &slug)...)
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("site_id"), &siteId)...)
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("key"), &key)...)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	slug := state.AccountSlug.ValueString()
+	siteId := state.SiteId.ValueString()
+	key := state.Key.ValueString()
 
-	envVar, err := r.client.GetEnvVar(slug, siteId, key)
+	envVar, err := r.client.GetEnvVar(ctx, slug, siteId, key)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Update Netlify Env variable",
 			err.Error())
+		return
+	}
+
+	var scopes []string
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, true)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	for _, scope := range data.Scopes.Elements() {
-		contain := false
-		for _, v := range envVar.Scopes {
-			if scope.String() == v {
-				contain = true
+	var res *netlify.EnvVar
+	if !data.Key.Equal(state.Key) || !data.Scopes.Equal(state.Scopes) || !data.IsSecret.Equal(state.IsSecret) {
+		// Renaming the variable or changing its scopes or secrecy goes through
+		// a full replace of the variable, values included.
+		for _, scope := range scopes {
+			contain := false
+			for _, v := range envVar.Scopes {
+				if scope == v {
+					contain = true
+				}
+			}
+			if !contain {
+				envVar.Scopes = append(envVar.Scopes, scope)
 			}
 		}
-		if !contain {
-			envVar.Scopes = append(envVar.Scopes, scope.String())
+		envVar.Key = data.Key.ValueString()
+		envVar.IsSecret = data.IsSecret.ValueBool()
+		envVar.Values = envVarValuesFromModel(data.Values)
+
+		res, err = r.client.UpdateEnvVar(ctx, slug, siteId, key, *envVar)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Netlify Env variable",
+				err.Error())
+			return
+		}
+	} else {
+		// Only the values changed: touch the contexts that differ and leave
+		// the others alone.
+		planned := envVarValuesByContext(envVarValuesFromModel(data.Values))
+		previous := envVarValuesByContext(envVarValuesFromModel(state.Values))
+		remote := envVarValuesByContext(envVar.Values)
+
+		for contextKey, value := range planned {
+			if prev, ok := previous[contextKey]; ok && prev.Value == value.Value {
+				continue
+			}
+			_, err = r.client.SetEnvVarValue(ctx, slug, siteId, key, value)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Update Netlify Env variable",
+					fmt.Sprintf("Unable to set value for context %s, got error: %s", contextKey, err))
+				return
+			}
 		}
-	}
-	envVar.Key = data.Key.ValueString()
-	envVar.IsSecret = data.IsSecret.ValueBool()
 
-	res, err := r.client.UpdateEnvVar(slug, siteId, key, *envVar)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Update Netlify Env variable",
-			err.Error())
+		for contextKey := range previous {
+			if _, ok := planned[contextKey]; ok {
+				continue
+			}
+			value, ok := remote[contextKey]
+			if !ok {
+				continue
+			}
+			err = r.client.DeleteEnvVarValue(ctx, slug, siteId, key, value.Id)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Update Netlify Env variable",
+					fmt.Sprintf("Unable to delete value for context %s, got error: %s", contextKey, err))
+				return
+			}
+		}
+
+		res, err = r.client.GetEnvVar(ctx, slug, siteId, key)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Netlify Env variable",
+				err.Error())
+			return
+		}
 	}
 
 	scopeList, diags := types.ListValueFrom(ctx, types.StringType, res.Scopes)
@@ -216,6 +357,7 @@ func (r *EnvVarResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	data.Scopes = scopeList
+	data.Values = envVarValuesToModel(res, data.Values)
 	data.IsSecret = types.BoolValue(res.IsSecret)
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -231,8 +373,16 @@ func (r *EnvVarResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteEnvVar(data.AccountSlug.ValueString(), data.SiteId.ValueString(), data.Key.ValueString())
-	if err != nil {
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteEnvVar(ctx, data.AccountSlug.ValueString(), data.SiteId.ValueString(), data.Key.ValueString())
+	if err != nil && !netlify.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Unable to delete EnvVarResource",
 			err.Error(),
@@ -248,3 +398,64 @@ func (r *EnvVarResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *EnvVarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// envVarContextKey identifies a value by its deploy context, e.g.
+// "production" or "branch:staging".
+func envVarContextKey(value netlify.EnvVarValue) string {
+	if value.ContextParameter == "" {
+		return value.Context
+	}
+	return value.Context + ":" + value.ContextParameter
+}
+
+func envVarValuesByContext(values []netlify.EnvVarValue) map[string]netlify.EnvVarValue {
+	byContext := make(map[string]netlify.EnvVarValue, len(values))
+	for _, value := range values {
+		byContext[envVarContextKey(value)] = value
+	}
+	return byContext
+}
+
+func envVarValuesFromModel(values []envVarValueModel) []netlify.EnvVarValue {
+	var res []netlify.EnvVarValue
+	for _, value := range values {
+		res = append(res, netlify.EnvVarValue{
+			Value:            value.Value.ValueString(),
+			Context:          value.Context.ValueString(),
+			ContextParameter: value.ContextParameter.ValueString(),
+		})
+	}
+	return res
+}
+
+// envVarValuesToModel maps the values returned by the API onto the model.
+// Netlify does not return the value of secret variables, so the previously
+// known value for the same context is kept in that case.
+func envVarValuesToModel(envVar *netlify.EnvVar, known []envVarValueModel) []envVarValueModel {
+	knownByContext := make(map[string]envVarValueModel, len(known))
+	for _, value := range known {
+		knownByContext[envVarContextKey(netlify.EnvVarValue{
+			Context:          value.Context.ValueString(),
+			ContextParameter: value.ContextParameter.ValueString(),
+		})] = value
+	}
+
+	var res []envVarValueModel
+	for _, value := range envVar.Values {
+		model := envVarValueModel{
+			Value:            types.StringValue(value.Value),
+			Context:          types.StringValue(value.Context),
+			ContextParameter: types.StringNull(),
+		}
+		if value.ContextParameter != "" {
+			model.ContextParameter = types.StringValue(value.ContextParameter)
+		}
+		if envVar.IsSecret && value.Value == "" {
+			if prev, ok := knownByContext[envVarContextKey(value)]; ok {
+				model.Value = prev.Value
+			}
+		}
+		res = append(res, model)
+	}
+	return res
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"terraform-provider-netlify/internal/netlifytest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEnvVarResource(t *testing.T) {
+	srv := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccEnvVarResourceConfig("prod-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_env_var.test", "key", "API_URL"),
+					resource.TestCheckResourceAttr("netlify_env_var.test", "values.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("netlify_env_var.test", "values.*", map[string]string{
+						"context": "production",
+						"value":   "prod-1",
+					}),
+				),
+			},
+			{
+				// Only the production value changes, so the variable must not
+				// be replaced as a whole.
+				Config: testAccProviderConfig + testAccEnvVarResourceConfig("prod-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("netlify_env_var.test", "values.*", map[string]string{
+						"context": "production",
+						"value":   "prod-2",
+					}),
+					func(_ *terraform.State) error {
+						for _, req := range srv.Requests() {
+							if strings.HasPrefix(req, http.MethodPut+" ") {
+								return fmt.Errorf("unexpected env var replace: %s", req)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvVarResourceConfig(production string) string {
+	return `
+resource "netlify_env_var" "test" {
+  account_slug = "` + netlifytest.AccountSlug + `"
+  site_id      = "site"
+  key          = "API_URL"
+
+  values = [
+    {
+      context = "production"
+      value   = "` + production + `"
+    },
+    {
+      context           = "branch"
+      context_parameter = "staging"
+      value             = "staging"
+    },
+  ]
+}
+`
+}
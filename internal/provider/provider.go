@@ -2,14 +2,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"terraform-provider-netlify/internal/netlify"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,6 +22,10 @@ var (
 	_ provider.Provider = &netlifyProvider{}
 )
 
+// defaultTimeout bounds every resource operation when no timeouts block is
+// configured.
+const defaultTimeout = 20 * time.Minute
+
 // New is a helper function to simplify provider server and testing implementation.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -43,6 +51,12 @@ func (p *netlifyProvider) Metadata(_ context.Context, _ provider.MetadataRequest
 
 type netlifyProviderModel struct {
 	Personal_token types.String `tfsdk:"personal_token"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	BaseURL        types.String `tfsdk:"base_url"`
+	UserAgent      types.String `tfsdk:"user_agent"`
+	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
+	ProxyURL       types.String `tfsdk:"proxy_url"`
+	CABundleFile   types.String `tfsdk:"ca_bundle_file"`
 }
 
 // Schema defines the provider-level schema for configuration data.
@@ -53,6 +67,36 @@ func (p *netlifyProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Description: "Netlify personal token for the Netlify API. May aslo be provided via NETLIFY_PERSONAL_TOKEN env variable",
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Number of times a request is retried after a rate limit, a server error or a connection failure. Server errors and broken connections are only retried for requests that are safe to send twice. Defaults to 4",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				Description: "Base URL of the Netlify API. May also be provided via NETLIFY_BASE_URL env variable. Defaults to " + netlify.DefaultBaseURL,
+				Optional:    true,
+			},
+			"user_agent": schema.StringAttribute{
+				Description: "Product token appended to the User-Agent header sent to the Netlify API",
+				Optional:    true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Timeout of a single HTTP request in seconds. Defaults to no timeout",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used to reach the Netlify API. Defaults to the HTTPS_PROXY env variable",
+				Optional:    true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				Description: "Path to a PEM file of certificate authorities trusted in addition to the system ones",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -83,11 +127,20 @@ func (p *netlifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// with Terraform configuration value if set.
 
 	personalToken := os.Getenv("NETLIFY_PERSONAL_TOKEN")
+	baseURL := os.Getenv("NETLIFY_BASE_URL")
 
 	if !config.Personal_token.IsNull() {
 		personalToken = config.Personal_token.ValueString()
 	}
 
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
+	}
+
+	if baseURL == "" {
+		baseURL = netlify.DefaultBaseURL
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -105,7 +158,32 @@ func (p *netlifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	client, err := netlify.NewNetlifyClient("https://api.netlify.com/api/v1/", personalToken)
+	userAgent := fmt.Sprintf("terraform-provider-netlify/%s Terraform/%s", p.version, req.TerraformVersion)
+	if !config.UserAgent.IsNull() && config.UserAgent.ValueString() != "" {
+		userAgent += " " + config.UserAgent.ValueString()
+	}
+
+	opts := netlify.ClientOptions{
+		UserAgent: userAgent,
+		Timeout:   time.Duration(config.RequestTimeout.ValueInt64()) * time.Second,
+		ProxyURL:  config.ProxyURL.ValueString(),
+		Debug:     os.Getenv("NETLIFY_HTTP_DEBUG") != "",
+	}
+
+	if !config.CABundleFile.IsNull() {
+		caBundle, err := os.ReadFile(config.CABundleFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_bundle_file"),
+				"Unable to Read CA Bundle",
+				"The provider cannot read the CA bundle file: "+err.Error(),
+			)
+			return
+		}
+		opts.CABundle = caBundle
+	}
+
+	client, err := netlify.NewNetlifyClient(baseURL, personalToken, opts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Netlify API Client",
@@ -116,6 +194,10 @@ func (p *netlifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	if !config.MaxRetries.IsNull() {
+		client.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
 	// Make the Netlify client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -126,7 +208,12 @@ func (p *netlifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 func (p *netlifyProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewSiteDataSource,
+		NewSitesDataSource,
 		NewCurrentUserDataSource,
+		NewDNSZoneDataSource,
+		NewBuildHooksDataSource,
+		NewDeploysDataSource,
+		NewDeployDataSource,
 	}
 }
 
@@ -136,5 +223,13 @@ func (p *netlifyProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewSiteResource,
 		NewDeployKeyResource,
 		NewEnvVarRessource,
+		NewSiteSSLCertificateResource,
+		NewDNSZoneResource,
+		NewDNSRecordResource,
+		NewBuildHookResource,
+		NewDeployNotificationResource,
+		NewBuildResource,
+		NewDeployResource,
+		NewSitePublishedDeployResource,
 	}
 }
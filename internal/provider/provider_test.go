@@ -6,6 +6,8 @@ package provider
 import (
 	"testing"
 
+	"terraform-provider-netlify/internal/netlifytest"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -15,7 +17,7 @@ import (
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"scaffolding": providerserver.NewProtocol6WithError(New("test")()),
+	"netlify": providerserver.NewProtocol6WithError(New("test")()),
 }
 
 func testAccPreCheck(t *testing.T) {
@@ -23,3 +25,22 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// newTestServer starts a fake Netlify API and points the provider at it
+// through the NETLIFY_BASE_URL and NETLIFY_PERSONAL_TOKEN env variables, so
+// acceptance tests run offline.
+func newTestServer(t *testing.T) *netlifytest.Server {
+	t.Helper()
+
+	srv := netlifytest.NewServer()
+	t.Cleanup(srv.Close)
+	t.Setenv("NETLIFY_BASE_URL", srv.BaseURL())
+	t.Setenv("NETLIFY_PERSONAL_TOKEN", netlifytest.Token)
+	return srv
+}
+
+const testAccProviderConfig = `
+provider "netlify" {
+  max_retries = 2
+}
+`
@@ -3,10 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"terraform-provider-netlify/internal/netlify"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +29,9 @@ type SiteDataSourceModel struct {
 }
 
 var (
-	_ datasource.DataSource              = &SiteDataSource{}
-	_ datasource.DataSourceWithConfigure = &SiteDataSource{}
+	_ datasource.DataSource                     = &SiteDataSource{}
+	_ datasource.DataSourceWithConfigure        = &SiteDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &SiteDataSource{}
 )
 
 func NewSiteDataSource() datasource.DataSource {
@@ -57,16 +61,22 @@ func (d *SiteDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 
 func (d *SiteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Site Datasource",
+		MarkdownDescription: "Site Datasource. Exactly one of `id`, `name` or `custom_domain` must be set to look the site up",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Computed: true,
+				Description: "Name of the site to look up",
+				Optional:    true,
+				Computed:    true,
 			},
 			"custom_domain": schema.StringAttribute{
-				Computed: true,
+				Description: "Custom domain of the site to look up",
+				Optional:    true,
+				Computed:    true,
 			},
 			"id": schema.StringAttribute{
-				Required: true,
+				Description: "ID of the site to look up",
+				Optional:    true,
+				Computed:    true,
 			},
 			"url": schema.StringAttribute{
 				Computed: true,
@@ -94,7 +104,20 @@ func (d *SiteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	site, err := d.client.GetSite(data.Id.ValueString())
+	var site *netlify.Site
+	var err error
+	switch {
+	case !data.Id.IsNull():
+		site, err = d.client.GetSite(ctx, data.Id.ValueString())
+	case !data.Name.IsNull():
+		site, err = d.findSite(ctx, netlify.ListSitesParams{Name: data.Name.ValueString()}, "name", data.Name.ValueString(), func(site *netlify.Site) bool {
+			return site.Name == data.Name.ValueString()
+		})
+	default:
+		site, err = d.findSite(ctx, netlify.ListSitesParams{}, "custom domain", data.CustomDomain.ValueString(), func(site *netlify.Site) bool {
+			return strings.EqualFold(site.CustomDomain, data.CustomDomain.ValueString())
+		})
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Netlify Site",
@@ -103,16 +126,61 @@ func (d *SiteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	data.Id = types.StringValue(site.Id)
-	data.Name = types.StringValue(site.Name)
-	data.CustomDomain = types.StringValue(site.CustomDomain)
-	data.Url = types.StringValue(site.Url)
-	data.State = types.StringValue(site.State)
-	data.CreatedAt = types.StringValue(site.CreatedAt)
-	data.UpdatedAt = types.StringValue(site.UpdatedAt)
+	data = siteDataSourceModelFrom(site)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+func (d *SiteDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("custom_domain"),
+		),
+	}
+}
+
+// findSite lists the sites matching params and returns the single one
+// accepted by match.
+func (d *SiteDataSource) findSite(ctx context.Context, params netlify.ListSitesParams, key string, value string, match func(site *netlify.Site) bool) (*netlify.Site, error) {
+	sites, err := d.client.ListSites(ctx, params, netlify.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var found []*netlify.Site
+	for i := range sites {
+		if match(&sites[i]) {
+			found = append(found, &sites[i])
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no site found with %s %q", key, value)
+	case 1:
+		return found[0], nil
+	default:
+		ids := make([]string, 0, len(found))
+		for _, site := range found {
+			ids = append(ids, site.Id)
+		}
+		return nil, fmt.Errorf("%d sites found with %s %q (%s), use id to select one", len(found), key, value, strings.Join(ids, ", "))
+	}
+}
+
+func siteDataSourceModelFrom(site *netlify.Site) SiteDataSourceModel {
+	return SiteDataSourceModel{
+		Id:           types.StringValue(site.Id),
+		Name:         types.StringValue(site.Name),
+		CustomDomain: types.StringValue(site.CustomDomain),
+		Url:          types.StringValue(site.Url),
+		State:        types.StringValue(site.State),
+		CreatedAt:    types.StringValue(site.CreatedAt),
+		UpdatedAt:    types.StringValue(site.UpdatedAt),
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSiteDataSource(t *testing.T) {
+	newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `
+resource "netlify_site" "test" {
+  name          = "lookup"
+  custom_domain = "www.example.com"
+}
+
+data "netlify_site" "by_id" {
+  id = netlify_site.test.id
+}
+
+data "netlify_site" "by_name" {
+  name = netlify_site.test.name
+}
+
+data "netlify_site" "by_domain" {
+  custom_domain = netlify_site.test.custom_domain
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netlify_site.by_id", "name", "netlify_site.test", "name"),
+					resource.TestCheckResourceAttrPair("data.netlify_site.by_name", "id", "netlify_site.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netlify_site.by_domain", "id", "netlify_site.test", "id"),
+				),
+			},
+			{
+				Config: testAccProviderConfig + `
+data "netlify_site" "missing" {
+  name = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`no site found with name "missing"`),
+			},
+			{
+				Config: testAccProviderConfig + `
+data "netlify_site" "ambiguous" {
+  id   = "1"
+  name = "lookup"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				// A lookup matching several sites must not pick one of them.
+				Config: testAccProviderConfig + `
+resource "netlify_site" "blue" {
+  name          = "blue"
+  custom_domain = "shared.example.com"
+}
+
+resource "netlify_site" "green" {
+  name          = "green"
+  custom_domain = "shared.example.com"
+}
+
+data "netlify_site" "shared" {
+  custom_domain = "shared.example.com"
+
+  depends_on = [netlify_site.blue, netlify_site.green]
+}
+`,
+				ExpectError: regexp.MustCompile(`2 sites found with custom domain "shared.example.com"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-netlify/internal/netlify"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SitePublishedDeployResource{}
+	_ resource.ResourceWithImportState = &SitePublishedDeployResource{}
+	_ resource.ResourceWithConfigure   = &SitePublishedDeployResource{}
+)
+
+func NewSitePublishedDeployResource() resource.Resource {
+	return &SitePublishedDeployResource{}
+}
+
+// SitePublishedDeployResource defines the resource implementation.
+type SitePublishedDeployResource struct {
+	client *netlify.NetlifyClient
+}
+
+// SitePublishedDeployResourceModel describes the resource data model.
+type SitePublishedDeployResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	SiteId      types.String   `tfsdk:"site_id"`
+	DeployId    types.String   `tfsdk:"deploy_id"`
+	Locked      types.Bool     `tfsdk:"locked"`
+	PublishedAt types.String   `tfsdk:"published_at"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SitePublishedDeployResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_published_deploy"
+}
+
+func (r *SitePublishedDeployResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Deploy published in production by a site, used to pin or roll back a site. " +
+			"Destroying the resource unlocks the deploy, if locked, and leaves it published",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the site",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Description: "ID of the site",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deploy_id": schema.StringAttribute{
+				Description: "ID of the deploy to publish. It must belong to the site and be ready",
+				Required:    true,
+			},
+			"locked": schema.BoolAttribute{
+				Description: "Whether to lock the published deploy, stopping new deploys from being published automatically until unlocked",
+				Optional:    true,
+			},
+			"published_at": schema.StringAttribute{
+				Description: "When the deploy was published",
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SitePublishedDeployResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SitePublishedDeployResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SitePublishedDeployResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	deploy, err := r.publish(ctx, &data, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Publish Netlify Deploy",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Id = data.SiteId
+	data.refresh(deploy)
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SitePublishedDeployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SitePublishedDeployResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	siteId := data.Id.ValueString()
+	site, err := r.client.GetSite(ctx, siteId)
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify Site not found, removing its published deploy from state", map[string]any{"id": siteId})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Site Published Deploy",
+			err.Error(),
+		)
+		return
+	}
+	if site.PublishedDeploy == nil {
+		tflog.Warn(ctx, "Netlify Site has no published deploy, removing it from state", map[string]any{"id": siteId})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.SiteId = data.Id
+	data.refresh(site.PublishedDeploy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SitePublishedDeployResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SitePublishedDeployResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	deploy, err := r.publish(ctx, &data, !data.DeployId.Equal(state.DeployId))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Publish Netlify Deploy",
+			err.Error(),
+		)
+		return
+	}
+
+	data.refresh(deploy)
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SitePublishedDeployResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SitePublishedDeployResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Locked.ValueBool() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Unlocking resumes auto publishing, the deploy stays published.
+	_, err := r.client.UnlockDeploy(ctx, data.DeployId.ValueString())
+	if err != nil && !netlify.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Unlock Netlify Deploy",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *SitePublishedDeployResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// publish publishes the deploy of data, unless restore is false because it
+// is already published, then locks or unlocks it as configured.
+func (r *SitePublishedDeployResource) publish(ctx context.Context, data *SitePublishedDeployResourceModel, restore bool) (*netlify.Deploy, error) {
+	siteId := data.SiteId.ValueString()
+	deployId := data.DeployId.ValueString()
+
+	var deploy *netlify.Deploy
+	var err error
+	if restore {
+		deploy, err = r.client.RestoreSiteDeploy(ctx, siteId, deployId)
+	} else {
+		deploy, err = r.client.GetDeploy(ctx, deployId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	locked := data.Locked.ValueBool()
+	switch {
+	case locked && !deploy.Locked:
+		return r.client.LockDeploy(ctx, deployId)
+	case !locked && deploy.Locked:
+		return r.client.UnlockDeploy(ctx, deployId)
+	}
+	return deploy, nil
+}
+
+// refresh sets the model from the published deploy. An unset locked
+// attribute only shows up once the deploy gets locked outside of Terraform.
+func (data *SitePublishedDeployResourceModel) refresh(deploy *netlify.Deploy) {
+	data.DeployId = types.StringValue(deploy.Id)
+	if !data.Locked.IsNull() || deploy.Locked {
+		data.Locked = types.BoolValue(deploy.Locked)
+	}
+	data.PublishedAt = types.StringValue(deploy.PublishedAt)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSitePublishedDeployResource(t *testing.T) {
+	newTestServer(t)
+
+	v1 := t.TempDir()
+	v2 := t.TempDir()
+	testAccWriteFile(t, filepath.Join(v1, "index.html"), "<h1>v1</h1>")
+	testAccWriteFile(t, filepath.Join(v2, "index.html"), "<h1>v2</h1>")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccSitePublishedDeployResourceConfig(v1, v2, "v1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netlify_site_published_deploy.test", "deploy_id", "netlify_deploy.v1", "id"),
+					resource.TestCheckResourceAttr("netlify_site_published_deploy.test", "locked", "true"),
+				),
+			},
+			// Rolling forward keeps the site locked.
+			{
+				Config: testAccProviderConfig + testAccSitePublishedDeployResourceConfig(v1, v2, "v2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netlify_site_published_deploy.test", "deploy_id", "netlify_deploy.v2", "id"),
+					resource.TestCheckResourceAttrSet("netlify_site_published_deploy.test", "published_at"),
+				),
+			},
+			{
+				ResourceName:            "netlify_site_published_deploy.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccProviderConfig + testAccSitePublishedDeployResourceConfig(v1, v2, "v2", false),
+				Check:  resource.TestCheckResourceAttr("netlify_site_published_deploy.test", "locked", "false"),
+			},
+		},
+	})
+}
+
+func testAccSitePublishedDeployResourceConfig(v1 string, v2 string, published string, locked bool) string {
+	lockedValue := "false"
+	if locked {
+		lockedValue = "true"
+	}
+	return `
+resource "netlify_site" "test" {
+  name = "pinned-site"
+}
+
+resource "netlify_deploy" "v1" {
+  site_id   = netlify_site.test.id
+  directory = "` + filepath.ToSlash(v1) + `"
+}
+
+resource "netlify_deploy" "v2" {
+  site_id   = netlify_site.test.id
+  directory = "` + filepath.ToSlash(v2) + `"
+}
+
+resource "netlify_site_published_deploy" "test" {
+  site_id   = netlify_site.test.id
+  deploy_id = netlify_deploy.` + published + `.id
+  locked    = ` + lockedValue + `
+}
+`
+}
@@ -9,10 +9,19 @@ import (
 	"terraform-provider-netlify/internal/netlify"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -33,24 +42,40 @@ type SiteResource struct {
 
 // SiteResourceModel describes the resource data model.
 type SiteResourceModel struct {
-	Id           types.String    `tfsdk:"id"`
-	CustomDomain types.String    `tfsdk:"custom_domain"`
-	Name         types.String    `tfsdk:"name"`
-	Url          types.String    `tfsdk:"url"`
-	CreatedAt    types.String    `tfsdk:"created_at"`
-	UpdatedAt    types.String    `tfsdk:"updated_at"`
-	State        types.String    `tfsdk:"state"`
-	Repository   repositoryModel `tfsdk:"repository"`
-	LastUpdated  types.String    `tfsdk:"last_updated"`
+	Id            types.String     `tfsdk:"id"`
+	CustomDomain  types.String     `tfsdk:"custom_domain"`
+	DomainAliases types.List       `tfsdk:"domain_aliases"`
+	ForceSSL      types.Bool       `tfsdk:"force_ssl"`
+	SSL           types.Bool       `tfsdk:"ssl"`
+	SslUrl        types.String     `tfsdk:"ssl_url"`
+	ProvisionSSL  types.Bool       `tfsdk:"provision_ssl"`
+	Name          types.String     `tfsdk:"name"`
+	Url           types.String     `tfsdk:"url"`
+	CreatedAt     types.String     `tfsdk:"created_at"`
+	UpdatedAt     types.String     `tfsdk:"updated_at"`
+	State         types.String     `tfsdk:"state"`
+	Repository    *repositoryModel `tfsdk:"repository"`
+	LastUpdated   types.String     `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value   `tfsdk:"timeouts"`
 }
 
 type repositoryModel struct {
-	Provider    types.String `tfsdk:"provider"`
-	DeployKeyId types.String `tfsdk:"deploy_key_id"`
-	RepoPath    types.String `tfsdk:"repo_path"`
-	RepoBranch  types.String `tfsdk:"repo_branch"`
-	Cmd         types.String `tfsdk:"cmd"`
-	Dir         types.String `tfsdk:"dir"`
+	Provider        types.String `tfsdk:"provider"`
+	DeployKeyId     types.String `tfsdk:"deploy_key_id"`
+	RepoPath        types.String `tfsdk:"repo_path"`
+	RepoBranch      types.String `tfsdk:"repo_branch"`
+	RepoUrl         types.String `tfsdk:"repo_url"`
+	Cmd             types.String `tfsdk:"cmd"`
+	Dir             types.String `tfsdk:"dir"`
+	FunctionsDir    types.String `tfsdk:"functions_dir"`
+	Base            types.String `tfsdk:"base"`
+	AllowedBranches types.List   `tfsdk:"allowed_branches"`
+	PublicRepo      types.Bool   `tfsdk:"public_repo"`
+	PrivateLogs     types.Bool   `tfsdk:"private_logs"`
+	StopBuilds      types.Bool   `tfsdk:"stop_builds"`
+	InstallationId  types.Int64  `tfsdk:"installation_id"`
+	Env             types.Map    `tfsdk:"env"`
+	SkipPrs         types.Bool   `tfsdk:"skip_prs"`
 }
 
 func (r *SiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,6 +96,36 @@ func (r *SiteResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed: true,
 				Optional: true,
 			},
+			"domain_aliases": schema.ListAttribute{
+				Description: "Additional domains the site is served on. Set to an empty list to remove every alias",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_ssl": schema.BoolAttribute{
+				Description: "Whether HTTP requests are redirected to HTTPS",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssl": schema.BoolAttribute{
+				Description: "Whether the site is served over HTTPS",
+				Computed:    true,
+			},
+			"ssl_url": schema.StringAttribute{
+				Description: "HTTPS URL of the site",
+				Computed:    true,
+			},
+			"provision_ssl": schema.BoolAttribute{
+				Description: "Provision a Let's Encrypt certificate for the custom domain and domain aliases, and wait for it to be issued. " +
+					"The certificate is provisioned again when the domains change or when its provisioning failed",
+				Optional: true,
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
@@ -90,29 +145,141 @@ func (r *SiteResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed: true,
 			},
 			"repository": schema.SingleNestedAttribute{
-				Required: true,
+				Description: "Git repository the site is built from. Leave unset for sites deployed manually or with the CLI",
+				Optional:    true,
 				Attributes: map[string]schema.Attribute{
 					"provider": schema.StringAttribute{
-						Required: true,
+						Description: "Git provider of the repository, e.g. github or gitlab",
+						Required:    true,
 					},
 					"deploy_key_id": schema.StringAttribute{
-						Required: true,
+						Description: "ID of the deploy key used to clone the repository",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
 					},
 					"repo_path": schema.StringAttribute{
-						Required: true,
+						Description: "Path of the repository, e.g. USER/REPO_NAME",
+						Required:    true,
 					},
 					"repo_branch": schema.StringAttribute{
-						Required: true,
+						Description: "Branch deployed to production",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"repo_url": schema.StringAttribute{
+						Description: "URL of the repository",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
 					},
 					"cmd": schema.StringAttribute{
-						Required: true,
+						Description: "Build command",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
 					},
 					"dir": schema.StringAttribute{
-						Required: true,
+						Description: "Directory to publish, relative to the base directory",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"functions_dir": schema.StringAttribute{
+						Description: "Directory of the serverless functions",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"base": schema.StringAttribute{
+						Description: "Base directory the build runs in",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"allowed_branches": schema.ListAttribute{
+						Description: "Branches built as branch deploys",
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"public_repo": schema.BoolAttribute{
+						Description: "Whether the repository is public",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"private_logs": schema.BoolAttribute{
+						Description: "Whether deploy logs are only visible to team members",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"stop_builds": schema.BoolAttribute{
+						Description: "Whether builds are stopped",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"installation_id": schema.Int64Attribute{
+						Description: "ID of the Git provider app installation",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"env": schema.MapAttribute{
+						Description: "Environment variables set in the build settings",
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Map{
+							mapplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"skip_prs": schema.BoolAttribute{
+						Description: "Whether deploy previews are skipped for pull requests",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
 					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -145,20 +312,20 @@ func (r *SiteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	tfRepo := data.Repository
-	netlifyRepo := netlify.SiteRequest{
-		Name:         data.Name.ValueString(),
-		CustomDomain: data.CustomDomain.ValueString(),
-		Repo: netlify.Repository{
-			Provider:    tfRepo.Provider.ValueString(),
-			Path:        tfRepo.RepoPath.ValueString(),
-			Branch:      tfRepo.RepoBranch.ValueString(),
-			DeployKeyId: tfRepo.DeployKeyId.ValueString(),
-			Cmd:         tfRepo.Cmd.ValueString(),
-			Dir:         tfRepo.Dir.ValueString(),
-		},
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	site, err := r.client.CreateSite(netlifyRepo)
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	netlifyRepo, diags := data.siteRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	site, err := r.client.CreateSite(ctx, netlifyRepo)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Netlify Site",
@@ -167,18 +334,38 @@ func (r *SiteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	data.Id = types.StringValue(site.Id)
-	data.Name = types.StringValue(site.Name)
-	data.CustomDomain = types.StringValue(site.CustomDomain)
-	data.Url = types.StringValue(site.Url)
-	data.State = types.StringValue(site.State)
-	data.CreatedAt = types.StringValue(site.CreatedAt)
-	data.UpdatedAt = types.StringValue(site.UpdatedAt)
+	// Save the site right away, so that a certificate failing or timing out
+	// taints it instead of leaving it out of the state.
+	resp.Diagnostics.Append(data.refresh(ctx, site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if !data.ProvisionSSL.ValueBool() {
+		return
+	}
+	site, err = r.provisionSSL(ctx, site.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Provision Netlify Site SSL Certificate",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *SiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -190,8 +377,21 @@ func (r *SiteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	siteId := data.Id.ValueString()
-	site, err := r.client.GetSite(siteId)
+	site, err := r.client.GetSite(ctx, siteId)
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify Site not found, removing it from state", map[string]any{"id": siteId})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Netlify Site",
@@ -200,13 +400,32 @@ func (r *SiteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	data.Id = types.StringValue(site.Id)
-	data.Name = types.StringValue(site.Name)
-	data.CustomDomain = types.StringValue(site.CustomDomain)
-	data.Url = types.StringValue(site.Url)
-	data.State = types.StringValue(site.State)
-	data.CreatedAt = types.StringValue(site.CreatedAt)
-	data.UpdatedAt = types.StringValue(site.UpdatedAt)
+	resp.Diagnostics.Append(data.refresh(ctx, site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A certificate that could not be issued, e.g. because the DNS of the
+	// domains does not point to Netlify yet, is provisioned again on the
+	// next apply.
+	if data.ProvisionSSL.ValueBool() && !site.SSL {
+		ssl, err := r.client.GetSiteSSL(ctx, siteId)
+		if err != nil && !netlify.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Read Netlify Site SSL Certificate",
+				err.Error(),
+			)
+			return
+		}
+		if err == nil && (ssl.State == "failed" || ssl.State == "error") {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("provision_ssl"),
+				"Netlify Site SSL Provisioning Failed",
+				fmt.Sprintf("The certificate of site %s is in state %q, it will be provisioned again on the next apply.", siteId, ssl.State),
+			)
+			data.ProvisionSSL = types.BoolValue(false)
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -223,27 +442,28 @@ func (r *SiteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	tfRepo := data.Repository
-	netlifyRepo := netlify.SiteRequest{
-		Name:         data.Name.ValueString(),
-		CustomDomain: data.CustomDomain.ValueString(),
-		Repo: netlify.Repository{
-			Provider:    tfRepo.Provider.ValueString(),
-			Path:        tfRepo.RepoPath.ValueString(),
-			Branch:      tfRepo.RepoBranch.ValueString(),
-			DeployKeyId: tfRepo.DeployKeyId.ValueString(),
-			Cmd:         tfRepo.Cmd.ValueString(),
-			Dir:         tfRepo.Dir.ValueString(),
-		},
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	var siteId string
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &siteId)...)
+	netlifyRepo, diags := data.siteRequest(ctx)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	site, err := r.client.UpdateSite(siteId, netlifyRepo)
+	var state SiteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	siteId := state.Id.ValueString()
+
+	site, err := r.client.UpdateSite(ctx, siteId, netlifyRepo)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Update Netlify Site",
@@ -252,13 +472,35 @@ func (r *SiteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	data.Id = types.StringValue(site.Id)
-	data.Name = types.StringValue(site.Name)
-	data.CustomDomain = types.StringValue(site.CustomDomain)
-	data.Url = types.StringValue(site.Url)
-	data.State = types.StringValue(site.State)
-	data.CreatedAt = types.StringValue(site.CreatedAt)
-	data.UpdatedAt = types.StringValue(site.UpdatedAt)
+	if data.Repository == nil && site.BuildSettings.RepoPath != "" {
+		site, err = r.client.UnlinkSiteRepository(ctx, siteId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Unlink Netlify Site Repository",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Certificates cover a fixed list of domains, so a new one is needed
+	// whenever the domains change.
+	domainsChanged := !data.CustomDomain.Equal(state.CustomDomain) || !data.DomainAliases.Equal(state.DomainAliases)
+	if data.ProvisionSSL.ValueBool() && (!state.ProvisionSSL.ValueBool() || domainsChanged) {
+		site, err = r.provisionSSL(ctx, siteId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Provision Netlify Site SSL Certificate",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -275,8 +517,16 @@ func (r *SiteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeleteSite(data.Id.ValueString())
-	if err != nil {
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteSite(ctx, data.Id.ValueString())
+	if err != nil && !netlify.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Site, got error: %s", err))
 		return
 	}
@@ -285,3 +535,122 @@ func (r *SiteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *SiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// provisionSSL provisions a certificate for the site, waits for it to be
+// issued and returns the site with SSL enabled.
+func (r *SiteResource) provisionSSL(ctx context.Context, siteId string) (*netlify.Site, error) {
+	tflog.Info(ctx, "Provisioning Netlify Site SSL certificate", map[string]any{"id": siteId})
+	if _, err := r.client.ProvisionSiteSSL(ctx, siteId); err != nil {
+		return nil, err
+	}
+	if _, err := r.client.WaitForSiteSSL(ctx, siteId); err != nil {
+		return nil, err
+	}
+	return r.client.GetSite(ctx, siteId)
+}
+
+// siteRequest builds the create or update request from the plan.
+func (data *SiteResourceModel) siteRequest(ctx context.Context) (netlify.SiteRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	req := netlify.SiteRequest{
+		Name:         data.Name.ValueString(),
+		CustomDomain: data.CustomDomain.ValueString(),
+		ForceSSL:     boolPointer(data.ForceSSL),
+	}
+	if !data.DomainAliases.IsNull() && !data.DomainAliases.IsUnknown() {
+		aliases := []string{}
+		diags.Append(data.DomainAliases.ElementsAs(ctx, &aliases, false)...)
+		req.DomainAliases = &aliases
+	}
+
+	tfRepo := data.Repository
+	if tfRepo == nil {
+		return req, diags
+	}
+
+	repo := netlify.Repository{
+		Provider:       tfRepo.Provider.ValueString(),
+		Path:           tfRepo.RepoPath.ValueString(),
+		Branch:         tfRepo.RepoBranch.ValueString(),
+		DeployKeyId:    tfRepo.DeployKeyId.ValueString(),
+		Cmd:            tfRepo.Cmd.ValueString(),
+		Dir:            tfRepo.Dir.ValueString(),
+		FunctionsDir:   tfRepo.FunctionsDir.ValueString(),
+		Base:           tfRepo.Base.ValueString(),
+		PublicRepo:     boolPointer(tfRepo.PublicRepo),
+		PrivateLogs:    boolPointer(tfRepo.PrivateLogs),
+		StopBuilds:     boolPointer(tfRepo.StopBuilds),
+		InstallationId: tfRepo.InstallationId.ValueInt64(),
+		SkipPrs:        boolPointer(tfRepo.SkipPrs),
+	}
+	diags.Append(tfRepo.AllowedBranches.ElementsAs(ctx, &repo.AllowedBranches, true)...)
+	diags.Append(tfRepo.Env.ElementsAs(ctx, &repo.Env, true)...)
+
+	req.Repo = &repo
+	return req, diags
+}
+
+// refresh sets the model from the site returned by the API.
+func (data *SiteResourceModel) refresh(ctx context.Context, site *netlify.Site) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(site.Id)
+	data.Name = types.StringValue(site.Name)
+	data.CustomDomain = types.StringValue(site.CustomDomain)
+	data.ForceSSL = types.BoolValue(site.ForceSSL)
+	data.SSL = types.BoolValue(site.SSL)
+	data.SslUrl = types.StringValue(site.SslUrl)
+	data.Url = types.StringValue(site.Url)
+	data.State = types.StringValue(site.State)
+	data.CreatedAt = types.StringValue(site.CreatedAt)
+	data.UpdatedAt = types.StringValue(site.UpdatedAt)
+
+	aliases := site.DomainAliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	domainAliases, d := types.ListValueFrom(ctx, types.StringType, aliases)
+	diags.Append(d...)
+	data.DomainAliases = domainAliases
+
+	settings := site.BuildSettings
+	if settings.RepoPath == "" {
+		data.Repository = nil
+		return diags
+	}
+
+	allowedBranches, d := types.ListValueFrom(ctx, types.StringType, settings.AllowedBranches)
+	diags.Append(d...)
+	env, d := types.MapValueFrom(ctx, types.StringType, settings.Env)
+	diags.Append(d...)
+
+	data.Repository = &repositoryModel{
+		Provider:        types.StringValue(settings.Provider),
+		DeployKeyId:     types.StringValue(settings.DeployKeyId),
+		RepoPath:        types.StringValue(settings.RepoPath),
+		RepoBranch:      types.StringValue(settings.RepoBranch),
+		RepoUrl:         types.StringValue(settings.RepoUrl),
+		Cmd:             types.StringValue(settings.Cmd),
+		Dir:             types.StringValue(settings.Dir),
+		FunctionsDir:    types.StringValue(settings.FunctionsDir),
+		Base:            types.StringValue(settings.Base),
+		AllowedBranches: allowedBranches,
+		PublicRepo:      types.BoolValue(settings.PublicRepo),
+		PrivateLogs:     types.BoolValue(settings.PrivateLogs),
+		StopBuilds:      types.BoolValue(settings.StopBuilds),
+		InstallationId:  types.Int64Value(settings.InstallationId),
+		Env:             env,
+		SkipPrs:         types.BoolValue(settings.SkipPrs),
+	}
+	return diags
+}
+
+// boolPointer returns nil for a null or unknown value, so that the setting is
+// not sent to the API.
+func boolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"terraform-provider-netlify/internal/netlifytest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSiteResource(t *testing.T) {
+	srv := newTestServer(t)
+	var siteId string
+	// The first create is rate limited and must be retried by the client.
+	srv.AddFault(netlifytest.Fault{Method: http.MethodPost, PathPrefix: "sites", StatusCode: http.StatusTooManyRequests, Times: 1})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccSiteResourceConfig("main"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("netlify_site.test", "id", func(value string) error {
+						siteId = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("netlify_site.test", "name", "test-site"),
+					resource.TestCheckResourceAttr("netlify_site.test", "state", "current"),
+				),
+			},
+			{
+				ResourceName:            "netlify_site.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccProviderConfig + testAccSiteResourceConfig("develop"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_site.test", "repository.repo_branch", "develop"),
+					resource.TestCheckResourceAttr("netlify_site.test", "repository.functions_dir", "netlify/functions"),
+					resource.TestCheckResourceAttr("netlify_site.test", "repository.allowed_branches.#", "2"),
+					resource.TestCheckResourceAttr("netlify_site.test", "repository.stop_builds", "false"),
+				),
+			},
+			{
+				// Changing the branch in the UI must show up as drift.
+				PreConfig: func() {
+					site := srv.Get("sites", siteId)
+					site["build_settings"].(map[string]any)["repo_branch"] = "hotfix"
+					srv.Put("sites", siteId, site)
+				},
+				Config:             testAccProviderConfig + testAccSiteResourceConfig("develop"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Removing the repository unlinks it without replacing the
+				// site.
+				Config: testAccProviderConfig + `
+resource "netlify_site" "test" {
+  name = "test-site"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("netlify_site.test", "id", &siteId),
+					resource.TestCheckNoResourceAttr("netlify_site.test", "repository.repo_path"),
+				),
+			},
+			{
+				// Deleting the site in the UI must plan a re-create.
+				PreConfig: func() {
+					srv.Remove("sites", siteId)
+				},
+				Config:             testAccProviderConfig + testAccSiteResourceConfig("develop"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteResourceSSL(t *testing.T) {
+	srv := newTestServer(t)
+	var siteId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccSiteResourceSSLConfig(`["www.example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_site.test", "ssl", "true"),
+					resource.TestCheckResourceAttr("netlify_site.test", "force_ssl", "true"),
+					resource.TestCheckResourceAttr("netlify_site.test", "domain_aliases.#", "1"),
+					resource.TestCheckResourceAttr("netlify_site.test", "domain_aliases.0", "www.example.com"),
+				),
+			},
+			{
+				Config: testAccProviderConfig + testAccSiteResourceSSLConfig(`[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_site.test", "ssl", "true"),
+					resource.TestCheckResourceAttr("netlify_site.test", "domain_aliases.#", "0"),
+					resource.TestCheckResourceAttrWith("netlify_site.test", "id", func(value string) error {
+						siteId = value
+						return nil
+					}),
+				),
+			},
+			{
+				// A certificate that failed to be issued must be provisioned
+				// again.
+				PreConfig: func() {
+					srv.Put("ssl", siteId, map[string]any{"state": "failed"})
+					site := srv.Get("sites", siteId)
+					site["ssl"] = false
+					srv.Put("sites", siteId, site)
+				},
+				Config:             testAccProviderConfig + testAccSiteResourceSSLConfig(`[]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccProviderConfig + testAccSiteResourceSSLConfig(`[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_site.test", "ssl", "true"),
+					resource.TestCheckResourceAttr("netlify_site.test", "provision_ssl", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSiteResourceSSLFailure(t *testing.T) {
+	srv := newTestServer(t)
+	srv.AddFault(netlifytest.Fault{Method: http.MethodPost, PathPrefix: "sites/1/ssl", StatusCode: http.StatusInternalServerError, Times: 1})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig + testAccSiteResourceSSLConfig(`[]`),
+				ExpectError: regexp.MustCompile(`Unable to Provision Netlify Site SSL Certificate`),
+			},
+			{
+				// The site created before the failure must be tainted and
+				// replaced, not left behind.
+				Config: testAccProviderConfig + testAccSiteResourceSSLConfig(`[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_site.test", "id", "2"),
+					resource.TestCheckResourceAttr("netlify_site.test", "ssl", "true"),
+					func(_ *terraform.State) error {
+						if srv.Get("sites", "1") != nil {
+							return fmt.Errorf("expected site 1 to be destroyed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteResourceSSLConfig(aliases string) string {
+	return `
+resource "netlify_site" "test" {
+  name           = "ssl-site"
+  custom_domain  = "example.com"
+  domain_aliases = ` + aliases + `
+  force_ssl      = true
+  provision_ssl  = true
+}
+`
+}
+
+func testAccSiteResourceConfig(branch string) string {
+	return `
+resource "netlify_deploy_key" "test" {}
+
+resource "netlify_site" "test" {
+  name = "test-site"
+
+  repository = {
+    provider      = "github"
+    repo_path     = "netlify/test"
+    repo_branch   = "` + branch + `"
+    deploy_key_id = netlify_deploy_key.test.id
+    cmd           = "npm run build"
+    dir           = "public"
+
+    functions_dir    = "netlify/functions"
+    allowed_branches = ["main", "develop"]
+    stop_builds      = false
+    env = {
+      NODE_VERSION = "18"
+    }
+  }
+}
+`
+}
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-netlify/internal/netlify"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SiteSSLCertificateResource{}
+	_ resource.ResourceWithImportState = &SiteSSLCertificateResource{}
+	_ resource.ResourceWithConfigure   = &SiteSSLCertificateResource{}
+)
+
+func NewSiteSSLCertificateResource() resource.Resource {
+	return &SiteSSLCertificateResource{}
+}
+
+// SiteSSLCertificateResource defines the resource implementation.
+type SiteSSLCertificateResource struct {
+	client *netlify.NetlifyClient
+}
+
+// SiteSSLCertificateResourceModel describes the resource data model.
+type SiteSSLCertificateResourceModel struct {
+	Id             types.String   `tfsdk:"id"`
+	SiteId         types.String   `tfsdk:"site_id"`
+	Certificate    types.String   `tfsdk:"certificate"`
+	Key            types.String   `tfsdk:"key"`
+	CACertificates types.String   `tfsdk:"ca_certificates"`
+	State          types.String   `tfsdk:"state"`
+	Domains        types.List     `tfsdk:"domains"`
+	ExpiresAt      types.String   `tfsdk:"expires_at"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SiteSSLCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_ssl_certificate"
+}
+
+func (r *SiteSSLCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom SSL certificate of a site. Netlify cannot remove a custom certificate, " +
+			"so destroying the resource only removes it from the state. " +
+			"The API never returns the PEM inputs: an imported certificate, or one replaced outside of Terraform, is uploaded again on the next apply",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the site, the certificate has no ID of its own",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Description: "ID of the site served with the certificate",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Description: "PEM encoded certificate",
+				Required:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "PEM encoded private key of the certificate",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"ca_certificates": schema.StringAttribute{
+				Description: "PEM encoded chain of intermediate certificates",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the certificate, custom once uploaded",
+				Computed:    true,
+			},
+			"domains": schema.ListAttribute{
+				Description: "Domains covered by the certificate",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiry date of the certificate",
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SiteSSLCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SiteSSLCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SiteSSLCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ssl, err := r.client.UploadSiteSSLCertificate(ctx, data.SiteId.ValueString(), data.certificate())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upload Netlify Site SSL Certificate",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Id = data.SiteId
+	resp.Diagnostics.Append(data.refresh(ctx, ssl)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SiteSSLCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SiteSSLCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	siteId := data.Id.ValueString()
+	ssl, err := r.client.GetSiteSSL(ctx, siteId)
+	if netlify.IsNotFound(err) {
+		tflog.Warn(ctx, "Netlify Site SSL certificate not found, removing it from state", map[string]any{"id": siteId})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Netlify Site SSL Certificate",
+			err.Error(),
+		)
+		return
+	}
+
+	// The PEM inputs are never returned by the API and are kept from the
+	// state, unless the served certificate is not the one last uploaded, e.g.
+	// after the site went back to a managed certificate. Forgetting the
+	// certificate then makes the next apply upload it again.
+	uploaded, diags := data.isServed(ctx, ssl)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !uploaded {
+		tflog.Warn(ctx, "Netlify Site SSL certificate replaced outside of Terraform, it will be uploaded again", map[string]any{"id": siteId, "state": ssl.State})
+		data.Certificate = types.StringNull()
+	}
+
+	data.SiteId = data.Id
+	resp.Diagnostics.Append(data.refresh(ctx, ssl)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SiteSSLCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SiteSSLCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Uploading a certificate replaces the previous one.
+	ssl, err := r.client.UploadSiteSSLCertificate(ctx, data.SiteId.ValueString(), data.certificate())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upload Netlify Site SSL Certificate",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, ssl)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SiteSSLCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SiteSSLCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "Netlify has no API to remove a custom SSL certificate, it keeps serving the site until replaced", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *SiteSSLCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (data *SiteSSLCertificateResourceModel) certificate() netlify.SiteSSLCertificate {
+	return netlify.SiteSSLCertificate{
+		Certificate:    data.Certificate.ValueString(),
+		Key:            data.Key.ValueString(),
+		CACertificates: data.CACertificates.ValueString(),
+	}
+}
+
+// isServed reports whether ssl is the certificate last uploaded, comparing
+// its state, expiry and domains with the ones stored at that time. Imported
+// certificates, for which nothing was stored, are assumed to be served.
+func (data *SiteSSLCertificateResourceModel) isServed(ctx context.Context, ssl *netlify.SiteSSL) (bool, diag.Diagnostics) {
+	if data.Certificate.IsNull() || data.ExpiresAt.IsNull() {
+		return true, nil
+	}
+
+	domains := ssl.Domains
+	if domains == nil {
+		domains = []string{}
+	}
+	domainsValue, diags := types.ListValueFrom(ctx, types.StringType, domains)
+
+	return data.State.ValueString() == ssl.State &&
+		data.ExpiresAt.ValueString() == ssl.ExpiresAt &&
+		data.Domains.Equal(domainsValue), diags
+}
+
+// refresh sets the computed attributes from the certificate returned by the
+// API.
+func (data *SiteSSLCertificateResourceModel) refresh(ctx context.Context, ssl *netlify.SiteSSL) diag.Diagnostics {
+	domains := ssl.Domains
+	if domains == nil {
+		domains = []string{}
+	}
+	domainsValue, diags := types.ListValueFrom(ctx, types.StringType, domains)
+
+	data.State = types.StringValue(ssl.State)
+	data.Domains = domainsValue
+	data.ExpiresAt = types.StringValue(ssl.ExpiresAt)
+	return diags
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSiteSSLCertificateResource(t *testing.T) {
+	srv := newTestServer(t)
+	var siteId string
+	firstCert, firstKey := testAccSelfSignedCertificate(t, "example.com")
+	secondCert, secondKey := testAccSelfSignedCertificate(t, "example.com", "www.example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccSiteSSLCertificateResourceConfig(firstCert, firstKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netlify_site_ssl_certificate.test", "id", "netlify_site.test", "id"),
+					resource.TestCheckResourceAttr("netlify_site_ssl_certificate.test", "state", "custom"),
+					resource.TestCheckResourceAttr("netlify_site_ssl_certificate.test", "domains.#", "1"),
+					resource.TestCheckResourceAttrSet("netlify_site_ssl_certificate.test", "expires_at"),
+					resource.TestCheckResourceAttrWith("netlify_site_ssl_certificate.test", "id", func(value string) error {
+						siteId = value
+						return nil
+					}),
+				),
+			},
+			{
+				// The PEM inputs cannot be read back from the API.
+				ResourceName:            "netlify_site_ssl_certificate.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "key", "last_updated"},
+			},
+			{
+				// Going back to a managed certificate outside of Terraform
+				// must upload the custom one again.
+				PreConfig: func() {
+					srv.Put("ssl", siteId, map[string]any{
+						"state":      "issued",
+						"domains":    []any{"example.com"},
+						"expires_at": time.Now().UTC().Add(90 * 24 * time.Hour).Format(time.RFC3339),
+					})
+				},
+				Config:             testAccProviderConfig + testAccSiteSSLCertificateResourceConfig(firstCert, firstKey),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccProviderConfig + testAccSiteSSLCertificateResourceConfig(firstCert, firstKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_site_ssl_certificate.test", "state", "custom"),
+				),
+			},
+			{
+				// A new certificate is uploaded in place.
+				Config: testAccProviderConfig + testAccSiteSSLCertificateResourceConfig(secondCert, secondKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netlify_site_ssl_certificate.test", "domains.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteSSLCertificateResourceConfig(cert string, key string) string {
+	return `
+resource "netlify_site" "test" {
+  name          = "custom-ssl"
+  custom_domain = "example.com"
+}
+
+resource "netlify_site_ssl_certificate" "test" {
+  site_id     = netlify_site.test.id
+  certificate = <<EOT
+` + cert + `EOT
+  key         = <<EOT
+` + key + `EOT
+}
+`
+}
+
+// testAccSelfSignedCertificate returns a PEM encoded certificate and key
+// valid for the given domains.
+func testAccSelfSignedCertificate(t *testing.T, domains ...string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: domains[0]},
+		DNSNames:     domains,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(cert), string(keyPem)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-netlify/internal/netlify"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type SitesDataSource struct {
+	client *netlify.NetlifyClient
+}
+
+type SitesDataSourceModel struct {
+	Name         types.String          `tfsdk:"name"`
+	Filter       types.String          `tfsdk:"filter"`
+	CustomDomain types.String          `tfsdk:"custom_domain"`
+	RepoPath     types.String          `tfsdk:"repo_path"`
+	Sites        []SiteDataSourceModel `tfsdk:"sites"`
+}
+
+var (
+	_ datasource.DataSource              = &SitesDataSource{}
+	_ datasource.DataSourceWithConfigure = &SitesDataSource{}
+)
+
+func NewSitesDataSource() datasource.DataSource {
+	return &SitesDataSource{}
+}
+
+func (d *SitesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sites"
+}
+
+func (d *SitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netlify.NetlifyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetlifyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sites Datasource, listing the sites matching every filter set",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return sites whose name contains this string",
+				Optional:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "Only return sites the user owns (owner), is a guest of (guest) or both (all)",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "owner", "guest"),
+				},
+			},
+			"custom_domain": schema.StringAttribute{
+				Description: "Only return sites with this custom domain",
+				Optional:    true,
+			},
+			"repo_path": schema.StringAttribute{
+				Description: "Only return sites linked to this repository, e.g. USER/REPO_NAME",
+				Optional:    true,
+			},
+			"sites": schema.ListNestedAttribute{
+				Description: "Sites found",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"custom_domain": schema.StringAttribute{
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"url": schema.StringAttribute{
+							Computed: true,
+						},
+						"state": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"updated_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SitesDataSourceModel
+	tflog.Debug(ctx, "Preparing to read Sites data source")
+
+	diag := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := netlify.ListSitesParams{
+		Name:   data.Name.ValueString(),
+		Filter: data.Filter.ValueString(),
+	}
+	sites, err := d.client.ListSites(ctx, params, netlify.ListOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Netlify Sites",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Sites = []SiteDataSourceModel{}
+	for i := range sites {
+		site := &sites[i]
+		if !data.CustomDomain.IsNull() && !strings.EqualFold(site.CustomDomain, data.CustomDomain.ValueString()) {
+			continue
+		}
+		if !data.RepoPath.IsNull() && !strings.EqualFold(site.BuildSettings.RepoPath, data.RepoPath.ValueString()) {
+			continue
+		}
+		data.Sites = append(data.Sites, siteDataSourceModelFrom(site))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSitesDataSource(t *testing.T) {
+	newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccSiteResourceConfig("main") + `
+data "netlify_sites" "test" {
+  name      = "test"
+  repo_path = "netlify/test"
+
+  depends_on = [netlify_site.test]
+}
+
+data "netlify_sites" "none" {
+  custom_domain = "unknown.example.com"
+
+  depends_on = [netlify_site.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netlify_sites.test", "sites.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netlify_sites.test", "sites.0.id", "netlify_site.test", "id"),
+					resource.TestCheckResourceAttr("data.netlify_sites.none", "sites.#", "0"),
+				),
+			},
+		},
+	})
+}